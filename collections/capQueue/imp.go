@@ -9,6 +9,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyQueue"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -24,6 +25,8 @@ type (
 	}
 
 	capQueueImp[T any] struct {
+		collections.QueueKind
+
 		count     int
 		head      *node[T]
 		tail      *node[T]
@@ -305,9 +308,11 @@ func (q *capQueueImp[T]) Clip() {
 }
 
 func (q *capQueueImp[T]) Equals(other any) bool {
-	s, ok := other.(collections.Collection[T])
-	return ok && q.count == s.Count() &&
-		q.Enumerate().Equals(s.Enumerate())
+	return equality.Queue[T](q, other)
+}
+
+func (q *capQueueImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](q)
 }
 
 func (q *capQueueImp[T]) Clone() collections.Queue[T] {
@@ -9,6 +9,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyStack"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -24,6 +25,8 @@ type (
 	}
 
 	capStackImp[T any] struct {
+		collections.StackKind
+
 		count     int
 		head      *node[T]
 		graveyard *node[T]
@@ -308,9 +311,11 @@ func (s *capStackImp[T]) Clip() {
 }
 
 func (s *capStackImp[T]) Equals(other any) bool {
-	s2, ok := other.(collections.Collection[T])
-	return ok && s.count == s2.Count() &&
-		s.Enumerate().Equals(s2.Enumerate())
+	return equality.Stack[T](s, other)
+}
+
+func (s *capStackImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](s)
 }
 
 func (s *capStackImp[T]) Clone() collections.Stack[T] {
@@ -0,0 +1,21 @@
+package collections
+
+import "io"
+
+// ClosableIterator is an iterator which holds onto resources,
+// such as goroutines or file handles, that should be released
+// when the iterator is no longer needed.
+//
+// Close should be called if the iterator is stopped before
+// `Next` returns false. Closing an iterator more than once,
+// or after it has finished, has no effect.
+//
+// The terminal operators, such as `First`, `Any`, and `ToSlice`, close
+// any iterator which can be closed once they are done reading from it,
+// and so does the sequence from `Seq` when the range loop is done.
+// Operators which read from other iterators, such as `Where` and `Take`,
+// close the iterators they read from when they are closed.
+type ClosableIterator[T any] interface {
+	Iterator[T]
+	io.Closer
+}
@@ -6,11 +6,17 @@ import (
 )
 
 // Collection is a collection of values.
+//
+// Collections are equal based on the kind of collection, not the
+// implementation, e.g. a list is equal to a linked list with the same values.
+// See the readonly interfaces for how each kind of collection is compared.
+// Collections which are equal have the same hash.
 type Collection[T any] interface {
 	Enumerable[T]
 	Countable
 	utils.Stringer
 	comp.Equatable
+	comp.Hashable
 
 	// Empty indicates if the collection is empty.
 	Empty() bool
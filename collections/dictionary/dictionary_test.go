@@ -8,6 +8,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/sortedDictionary"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
@@ -213,3 +214,26 @@ func Test_Dictionary_OnChange(t *testing.T) {
 	d.Clear()
 	check.StringAndReset(t, ``).Assert(buf)
 }
+
+func Test_Dictionary_EqualsAcrossImplementations(t *testing.T) {
+	d1 := With(map[string]int{`one`: 1, `two`: 2, `three`: 3})
+	d2 := sortedDictionary.With(map[string]int{`three`: 3, `two`: 2, `one`: 1})
+	check.True(t).Assert(d1.Equals(d2))
+	check.True(t).Assert(d2.Equals(d1))
+	check.True(t).Assert(d1.Readonly().Equals(d2.Readonly()))
+	check.Equal(t, d1.Hash()).Assert(d2.Hash())
+	check.Equal(t, d1.Hash()).Assert(d2.Readonly().Hash())
+
+	d2.Add(`two`, 22)
+	check.False(t).Assert(d1.Equals(d2))
+	check.False(t).Assert(d2.Equals(d1))
+	check.NotEqual(t, d1.Hash()).Assert(d2.Hash())
+
+	d2.Add(`two`, 2)
+	d2.Add(`four`, 4)
+	check.False(t).Assert(d1.Equals(d2))
+	check.False(t).Assert(d2.Equals(d1))
+
+	// A dictionary is not equal to other collections of tuples.
+	check.False(t).Assert(d1.Equals(d1.Enumerate()))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
 
@@ -264,18 +265,10 @@ func (d *dictionaryImp[TKey, TValue]) String() string {
 }
 
 func (d *dictionaryImp[TKey, TValue]) Equals(other any) bool {
-	d2, ok := other.(collections.Collection[collections.Tuple2[TKey, TValue]])
-	if !ok || d.Count() != d2.Count() {
-		return false
-	}
+	d2, ok := other.(collections.ReadonlyHashDictionary[TKey, TValue])
+	return ok && equality.Dictionary[TKey, TValue](d, d2)
+}
 
-	it := d2.Enumerate().Iterate()
-	for it.Next() {
-		key, value := it.Current().Values()
-		v2, ok := d.TryGet(key)
-		if !ok || !comp.Equal(v2, value) {
-			return false
-		}
-	}
-	return true
+func (d *dictionaryImp[TKey, TValue]) Hash() uint64 {
+	return equality.DictionaryHash[TKey, TValue](d)
 }
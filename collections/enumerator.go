@@ -164,6 +164,11 @@ type Enumerator[T any] interface {
 	// Buffered stores the result of an enumeration and repeats it back
 	// in the returned enumerator. Uses memory to reduce calculations.
 	// This will not read from this enumerator only when needed.
+	//
+	// The iterator for this enumerator is closed once it has been read to
+	// the end. If the returned enumerator is never read to the end, the
+	// iterator is kept open, and is never closed, so that a later iteration
+	// can continue it.
 	Buffered() Enumerator[T]
 
 	// StartsWith determines if the first enumerator starts with the given prefix.
@@ -124,3 +124,84 @@ func buffered_Comparison(b *testing.B, count int) {
 func Benchmark_Enumerator_Buffered_100000(b *testing.B) {
 	buffered_Comparison(b, 100000)
 }
+
+func pipeline_UnfusedWhere[T any](e collections.Enumerator[T], p collections.Predicate[T]) collections.Enumerator[T] {
+	return New(func() collections.Iterator[T] {
+		it := e.Iterate()
+		return iterator.New(func() (T, bool) {
+			for it.Next() {
+				if value := it.Current(); p(value) {
+					return value, true
+				}
+			}
+			return utils.Zero[T](), false
+		})
+	})
+}
+
+func pipeline_UnfusedSelect[TIn, TOut any](e collections.Enumerator[TIn], selector collections.Selector[TIn, TOut]) collections.Enumerator[TOut] {
+	return New(func() collections.Iterator[TOut] {
+		it := e.Iterate()
+		return iterator.New(func() (TOut, bool) {
+			if it.Next() {
+				return selector(it.Current()), true
+			}
+			return utils.Zero[TOut](), false
+		})
+	})
+}
+
+func pipeline_UnfusedEnumerate[T any](values ...T) collections.Enumerator[T] {
+	return New(func() collections.Iterator[T] {
+		index := -1
+		count := len(values)
+		return iterator.New(func() (T, bool) {
+			if count > 0 {
+				count--
+				index++
+				return values[index], true
+			}
+			return utils.Zero[T](), false
+		})
+	})
+}
+
+func pipeline_Comparison(b *testing.B, count int) {
+	src := make([]int, count)
+	for i := 0; i < count; i++ {
+		src[i] = int(rand.Int31())
+	}
+	isEven := func(value int) bool { return value%2 == 0 }
+	half := func(value int) int { return value / 2 }
+
+	var result1, result2 []int
+	var count1, count2 int
+	b.Run(`Unfused`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			e := pipeline_UnfusedEnumerate(src...)
+			result1 = pipeline_UnfusedSelect(pipeline_UnfusedWhere(e, isEven), half).ToSlice()
+			count1 = iterator.Count(e.Iterate())
+		}
+	})
+
+	b.Run(`Fused`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			e := Enumerate(src...)
+			result2 = Select(e.Where(isEven), half).ToSlice()
+			count2 = e.Count()
+		}
+	})
+
+	checkEqual(b, result1, result2)
+	checkEqual(b, count1, count2)
+}
+
+func Benchmark_Enumerator_Pipeline_10(b *testing.B) {
+	pipeline_Comparison(b, 10)
+}
+
+func Benchmark_Enumerator_Pipeline_10000(b *testing.B) {
+	pipeline_Comparison(b, 10000)
+}
@@ -1,11 +1,17 @@
 package enumerator
 
 import (
+	"context"
+	"io"
 	"strings"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
+	"github.com/Snow-Gremlin/goToolbox/collections/tuple3"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+	"github.com/Snow-Gremlin/goToolbox/internal/readers"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -49,6 +55,232 @@ func Repeat[T any](value T, count int) collections.Enumerator[T] {
 	})
 }
 
+// Unfold creates an enumerator that returns the values created by stepping
+// from the given seed state. The step is called with the current state and
+// returns the next value, the next state, and true to continue
+// or false to stop without returning the value.
+func Unfold[TState, T any](seed TState, step func(state TState) (T, TState, bool)) collections.Enumerator[T] {
+	if utils.IsNil(step) {
+		panic(terror.NilArg(`step`))
+	}
+	return New(func() collections.Iterator[T] {
+		state, done := seed, false
+		return iterator.New(func() (T, bool) {
+			if done {
+				return utils.Zero[T](), false
+			}
+			value, next, ok := step(state)
+			if !ok {
+				done = true
+				return utils.Zero[T](), false
+			}
+			state = next
+			return value, true
+		})
+	})
+}
+
+// Generate creates an infinite enumerator that returns the values
+// from calling the given generator each time a value is needed.
+//
+// The enumerator is known to be infinite, see `IsInfinite`.
+func Generate[T any](generator func() T) collections.Enumerator[T] {
+	if utils.IsNil(generator) {
+		panic(terror.NilArg(`generator`))
+	}
+	return newInfinite(func() collections.Iterator[T] {
+		return iterator.New(func() (T, bool) {
+			return generator(), true
+		})
+	})
+}
+
+// Iterate creates an infinite enumerator that returns the given seed
+// followed by the result of calling next on the prior returned value.
+//
+// The enumerator is known to be infinite, see `IsInfinite`.
+func Iterate[T any](seed T, next func(value T) T) collections.Enumerator[T] {
+	if utils.IsNil(next) {
+		panic(terror.NilArg(`next`))
+	}
+	return newInfinite(func() collections.Iterator[T] {
+		value, started := seed, false
+		return iterator.New(func() (T, bool) {
+			if started {
+				value = next(value)
+			}
+			started = true
+			return value, true
+		})
+	})
+}
+
+// Cycle creates an infinite enumerator that repeats all the values in the
+// given enumerator over and over. The given enumerator is iterated again for
+// each cycle, use `Buffered` on the given enumerator to only read it once.
+// If the given enumerator has no values then the cycle has no values.
+//
+// The enumerator is known to be infinite, see `IsInfinite`, even if the given
+// enumerator has no values, since checking would read the given enumerator.
+// This means actions like `ToSlice` and `Count` will panic for a cycle of
+// an empty enumerator, use `Take` or check `Empty` first to avoid the panic.
+func Cycle[T any](e collections.Enumerator[T]) collections.Enumerator[T] {
+	return newInfinite(func() collections.Iterator[T] {
+		it := e.Iterate()
+		empty := true
+		return iterator.NewClosable(func() (T, bool) {
+			if it.Next() {
+				empty = false
+				return it.Current(), true
+			}
+			if empty {
+				return utils.Zero[T](), false
+			}
+			it, empty = e.Iterate(), true
+			if it.Next() {
+				empty = false
+				return it.Current(), true
+			}
+			return utils.Zero[T](), false
+		}, func() error {
+			return iterator.Close(it)
+		})
+	})
+}
+
+// IsInfinite determines if the given enumerator is known to be infinite.
+//
+// Enumerators created with `Generate`, `Iterate`, and `Cycle` are known to be
+// infinite, as are the enumerators created from them with operators which do not
+// limit the number of values, such as `Where`, `Skip`, and `Select`.
+// Operators, such as `Count`, `Last`, `Sort`, and `Reverse`, which would never
+// return when reading an infinite enumerator will panic when given a known
+// infinite enumerator. Use `Take` or `TakeWhile` to limit the values.
+func IsInfinite[T any](e collections.Enumerator[T]) bool {
+	_, ok := e.(interface{ infinite() })
+	return ok
+}
+
+// FromChan creates an enumerator that enumerates the values read from
+// the given channel until the channel is closed.
+//
+// A channel can only be read once, so this enumerator can not be re-iterated.
+// Each iteration continues reading from the channel where the prior iteration
+// stopped, and once the channel is closed any following iterations are empty.
+// Use `Buffered` to be able to enumerate the values more than once.
+func FromChan[T any](ch <-chan T) collections.Enumerator[T] {
+	return New(func() collections.Iterator[T] {
+		return iterator.FromChan(ch)
+	})
+}
+
+// FromChanCtx creates an enumerator that enumerates the values read from
+// the given channel until the channel is closed or the given context is done.
+//
+// A channel can only be read once, so this enumerator can not be re-iterated.
+// Each iteration continues reading from the channel where the prior iteration
+// stopped, and once the channel is closed any following iterations are empty.
+// Use `Buffered` to be able to enumerate the values more than once.
+func FromChanCtx[T any](ctx context.Context, ch <-chan T) collections.Enumerator[T] {
+	if utils.IsNil(ctx) {
+		panic(terror.NilArg(`ctx`))
+	}
+	return New(func() collections.Iterator[T] {
+		return iterator.FromChanCtx(ctx, ch)
+	})
+}
+
+// ToChan starts a producer goroutine which enumerates all the values from
+// the given enumerator and writes them to the returned channel with the given
+// buffer size. The channel is closed once the enumerator has no more values
+// or the given context is done.
+//
+// If the channel will not be read to the end, cancel the context
+// to stop the producer goroutine, otherwise the producer will be leaked.
+//
+// The returned wait function blocks until the producer has stopped.
+// If the enumerator panics, the panic is recovered on the producer goroutine,
+// the channel is closed, and wait re-panics with the recovered panic as a terror.
+func ToChan[T any](ctx context.Context, e collections.Enumerator[T], buffer int) (<-chan T, func()) {
+	if utils.IsNil(ctx) {
+		panic(terror.NilArg(`ctx`))
+	}
+	return iterator.ToChan(ctx, e.Iterate(), buffer)
+}
+
+// WithContext creates an enumerator which enumerates the values from the
+// given enumerator until the given context is done. The context is checked
+// before each value is read. Once the context is done, the iteration stops
+// and the iterator from the given enumerator is closed, if it can be closed.
+//
+// Since the iteration is stopped by the context, the returned enumerator
+// is not known to be infinite even if the given enumerator is.
+// Use `ForeachCtx`, `DoUntilErrorCtx`, or `ToSliceCtx` to determine if
+// the enumeration was stopped because the context was done.
+func WithContext[T any](ctx context.Context, e collections.Enumerator[T]) collections.Enumerator[T] {
+	if utils.IsNil(ctx) {
+		panic(terror.NilArg(`ctx`))
+	}
+	return New(func() collections.Iterator[T] {
+		return iterator.WithContext(ctx, e.Iterate())
+	})
+}
+
+// ForeachCtx runs the given function for each value from the given enumerator
+// until the given context is done. The context is checked before each value is read.
+// If the context is done before all the values have been read, a terror wrapping
+// the context's error and the index of the next value is returned, otherwise nil.
+func ForeachCtx[T any](ctx context.Context, e collections.Enumerator[T], m func(value T)) error {
+	if utils.IsNil(m) {
+		panic(terror.NilArg(`m`))
+	}
+	return DoUntilErrorCtx(ctx, e, func(value T) error {
+		m(value)
+		return nil
+	})
+}
+
+// DoUntilErrorCtx runs the given function for each value from the given
+// enumerator until an error is returned by the selector or the given context
+// is done. The context is checked before each value is read.
+// If the selector returns an error then that error is returned.
+// If the context is done before all the values have been read, a terror wrapping
+// the context's error and the index of the next value is returned, otherwise nil.
+func DoUntilErrorCtx[T any](ctx context.Context, e collections.Enumerator[T], s collections.Selector[T, error]) error {
+	if utils.IsNil(ctx) {
+		panic(terror.NilArg(`ctx`))
+	}
+	if utils.IsNil(s) {
+		panic(terror.NilArg(`s`))
+	}
+	it := e.Iterate()
+	defer func() { _ = iterator.Close(it) }()
+	for index := 0; ; index++ {
+		if err := ctx.Err(); err != nil {
+			return terror.New(`enumeration canceled`, err).
+				With(`index`, index)
+		}
+		if !it.Next() {
+			return nil
+		}
+		if err := s(it.Current()); err != nil {
+			return err
+		}
+	}
+}
+
+// ToSliceCtx reads the values from the given enumerator into a slice
+// until the given context is done. The context is checked before each value is read.
+// If the context is done before all the values have been read, the values read so far
+// are returned with a terror wrapping the context's error and the index of the next value.
+func ToSliceCtx[T any](ctx context.Context, e collections.Enumerator[T]) ([]T, error) {
+	s := []T{}
+	err := ForeachCtx(ctx, e, func(value T) {
+		s = append(s, value)
+	})
+	return s, err
+}
+
 // SplitFunc creates an enumerator that enumerates all the strings from
 // splitting the given string with the given separator function.
 // The matched separators will not be returned.
@@ -94,28 +326,70 @@ func Split(value, sep string) collections.Enumerator[string] {
 // or `\u2029` (paragraph separator, UTF-8 is \xE2\x80\xA9).
 // This is useful for reading through a loaded file line by line.
 func Lines(value string) collections.Enumerator[string] {
-	return SplitFunc(value, func(part string) (int, int) {
-		for i, count := 0, len(part); i < count; i++ {
-			c := part[i]
-			switch c {
-			case '\r':
-				if i+1 < count && part[i+1] == '\n' {
-					return i, 2
-				}
-				return i, 1
-			case '\n':
-				if i+1 < count && part[i+1] == '\r' {
-					return i, 2
-				}
-				return i, 1
-			case '\xE2':
-				if i+2 < count && part[i+1] == '\x80' && part[i+2] == '\xA9' {
-					return i, 3
-				}
-			}
-		}
-		return -1, 0
-	})
+	return SplitFunc(value, readers.LineSeparator)
+}
+
+// ReaderSplit creates an enumerator that lazily enumerates all the strings from
+// splitting the data read from the given reader with the given separator function.
+// The matched separators will not be returned. The strings are split the same
+// as `SplitFunc` would split the whole data read into a string.
+// The strings given to the separator are only valid until it returns,
+// so the separator must not keep them.
+//
+// This can take an optional maximum token size, the default is
+// `bufio.MaxScanTokenSize`. If a string between separators is longer than
+// the maximum token size, or reading from the reader fails, a terror with
+// the index of the string being read is panicked.
+// Use `tryEnumerator.ReaderSplit` to get the error instead.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderSplit(r io.Reader, separator utils.StringMatcher, maxTokenSize ...int) collections.Enumerator[string] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	if utils.IsNil(separator) {
+		panic(terror.NilArg(`separator`))
+	}
+	maxSize := optional.TokenSize(maxTokenSize)
+	return New(readerIterable(readers.Split(r, separator, maxSize)))
+}
+
+// ReaderLines creates an enumerator that lazily enumerates all the lines
+// read from the given reader. The lines are split the same as `Lines`.
+// This is useful for streaming through a large file line by line.
+//
+// This can take an optional maximum line length, the default is
+// `bufio.MaxScanTokenSize`. If a line is longer than the maximum line length,
+// or reading from the reader fails, a terror with the index of the line
+// being read is panicked. Use `tryEnumerator.ReaderLines` to get the error instead.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderLines(r io.Reader, maxTokenSize ...int) collections.Enumerator[string] {
+	return ReaderSplit(r, readers.LineSeparator, maxTokenSize...)
+}
+
+// ReaderRunes creates an enumerator that lazily enumerates all the runes
+// decoded from the UTF-8 data read from the given reader.
+// Any invalid UTF-8 encoding is returned as `utf8.RuneError`.
+// If reading from the reader fails, a terror with the index
+// of the rune being read is panicked.
+// Use `tryEnumerator.ReaderRunes` to get the error instead.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderRunes(r io.Reader) collections.Enumerator[rune] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	return New(readerIterable(readers.Runes(r)))
 }
 
 // Errors creates an enumerator that walks all of the wrapped errors
@@ -136,9 +410,9 @@ func DoUntilNotZero[TIn, TOut any](it collections.Enumerator[TIn], s collections
 // Select changes one enumerator type into another by converting each value.
 // Typically this is used to select one value out of an enumerated value.
 func Select[TIn, TOut any](e collections.Enumerator[TIn], selector collections.Selector[TIn, TOut]) collections.Enumerator[TOut] {
-	return New(func() collections.Iterator[TOut] {
+	return keepInfinite(e, New(func() collections.Iterator[TOut] {
 		return iterator.Select(e.Iterate(), selector)
-	})
+	}))
 }
 
 // Expand creates an enumerator which enumerates through all the values from
@@ -156,7 +430,9 @@ func Expand[TIn, TOut any, TEnum collections.Iterable[TOut]](e collections.Enume
 // The reduce method is called with the prior returned value from the previous call.
 // The first call is given the initial value.
 // The last returned value from reduce is returned. or init if no values.
+// This will panic if the enumerator is known to be infinite.
 func Reduce[TIn, TOut any](e collections.Enumerator[TIn], init TOut, reducer collections.Reducer[TIn, TOut]) TOut {
+	checkFinite(e, `Reduce`)
 	return iterator.Reduce(e.Iterate(), init, reducer)
 }
 
@@ -183,9 +459,339 @@ func Chunk[T any](e collections.Enumerator[T], size int) collections.Enumerator[
 	})
 }
 
+// Scan creates an enumerator which performs a running reduction of the values
+// in the given enumerator and returns every intermediate reduced value.
+// The reducer is called with the prior returned value from the previous call.
+// The first call is given the initial value, which is not itself returned.
+func Scan[TIn, TOut any](e collections.Enumerator[TIn], init TOut, reducer collections.Reducer[TIn, TOut]) collections.Enumerator[TOut] {
+	if utils.IsNil(reducer) {
+		panic(terror.NilArg(`reducer`))
+	}
+	return keepInfinite(e, New(func() collections.Iterator[TOut] {
+		return iterator.Scan(e.Iterate(), init, reducer)
+	}))
+}
+
+// Pairwise creates an enumerator which returns each pair of adjacent values
+// from the given enumerator. If there are less than two values, no pairs are returned.
+func Pairwise[T any](e collections.Enumerator[T]) collections.Enumerator[collections.Tuple2[T, T]] {
+	return keepInfinite(e, New(func() collections.Iterator[collections.Tuple2[T, T]] {
+		return iterator.Pairwise(e.Iterate())
+	}))
+}
+
+// Partition splits the given enumerator into an enumerator of the values which
+// satisfy the given predicate and an enumerator of the values which do not.
+//
+// Both enumerators share one pass over the given enumerator. While one of the
+// enumerators is being read, the values for the other are buffered until they
+// are read. The values are kept so that both enumerators can be re-iterated
+// without iterating the given enumerator again.
+//
+// The iterator for the given enumerator is closed once it has been read to
+// the end. If neither enumerator is read to the end, the iterator is kept
+// open, and is never closed, so that a later iteration can continue it.
+func Partition[T any](e collections.Enumerator[T], p collections.Predicate[T]) (matching, nonMatching collections.Enumerator[T]) {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`predicate`))
+	}
+	var source collections.Iterator[T]
+	buffers := [2][]T{}
+	loading := true
+
+	side := func(index int) collections.Enumerator[T] {
+		return New(func() collections.Iterator[T] {
+			if loading && source == nil {
+				source = e.Iterate()
+			}
+
+			next := 0
+			return iterator.New(func() (T, bool) {
+				for next >= len(buffers[index]) {
+					if !loading {
+						return utils.Zero[T](), false
+					}
+					if !source.Next() {
+						_ = iterator.Close(source)
+						loading = false
+						source = nil
+						return utils.Zero[T](), false
+					}
+					value := source.Current()
+					if p(value) {
+						buffers[0] = append(buffers[0], value)
+					} else {
+						buffers[1] = append(buffers[1], value)
+					}
+				}
+				value := buffers[index][next]
+				next++
+				return value, true
+			})
+		})
+	}
+	return side(0), side(1)
+}
+
+// SplitWhen creates an enumerator which groups consecutive values from the given
+// enumerator into slices. A new group is started with each value that satisfies
+// the given predicate, except for the very first value which always starts a group.
+// This is useful for grouping lines of a log where a record begins with a header line.
+func SplitWhen[T any](e collections.Enumerator[T], p collections.Predicate[T]) collections.Enumerator[[]T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`predicate`))
+	}
+	return New(func() collections.Iterator[[]T] {
+		return iterator.SplitWhen(e.Iterate(), p)
+	})
+}
+
+// ChunkBy creates an enumerator which groups consecutive values from the given
+// enumerator into slices. Each group is a run of consecutive values which
+// all have the same key selected from them.
+// Values with the same key which are not consecutive are put into different groups.
+func ChunkBy[T any, K comparable](e collections.Enumerator[T], keySelector collections.Selector[T, K]) collections.Enumerator[[]T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	return New(func() collections.Iterator[[]T] {
+		return iterator.ChunkBy(e.Iterate(), keySelector)
+	})
+}
+
+// Intersperse creates an enumerator which returns the values from the given
+// enumerator with the given separator value between each pair of adjacent values.
+func Intersperse[T any](e collections.Enumerator[T], separator T) collections.Enumerator[T] {
+	return keepInfinite(e, New(func() collections.Iterator[T] {
+		return iterator.Intersperse(e.Iterate(), separator)
+	}))
+}
+
+// Product creates an enumerator for the cartesian product of the values in
+// the given enumerators in lexicographic order, where the last enumerator
+// varies the fastest. Each returned slice has one value from each of the given
+// enumerators and is a new slice which may be kept.
+//
+// The given enumerators are read into slices each time the product is iterated,
+// but the product itself is not, so very large products can be sampled with
+// methods like `Take` and `AtLeast` without reading all the values.
+func Product[T any](es ...collections.Enumerator[T]) collections.Enumerator[[]T] {
+	return New(func() collections.Iterator[[]T] {
+		values := make([][]T, len(es))
+		for i, e := range es {
+			values[i] = e.ToSlice()
+		}
+		return iterator.Product(values...)
+	})
+}
+
+// Product2 creates an enumerator for the cartesian product of the values in
+// the given enumerators as tuples in lexicographic order, where the second
+// value varies the fastest.
+//
+// The first enumerator is read lazily and the second enumerator is read into
+// a slice each time the product is iterated.
+func Product2[T1, T2 any](e1 collections.Enumerator[T1], e2 collections.Enumerator[T2]) collections.Enumerator[collections.Tuple2[T1, T2]] {
+	return New(func() collections.Iterator[collections.Tuple2[T1, T2]] {
+		seconds := e2.ToSlice()
+		return iterator.Expand(e1.Iterate(), func(first T1) collections.Iterable[collections.Tuple2[T1, T2]] {
+			return func() collections.Iterator[collections.Tuple2[T1, T2]] {
+				return iterator.Select(iterator.Iterate(seconds...), func(second T2) collections.Tuple2[T1, T2] {
+					return tuple2.New(first, second)
+				})
+			}
+		})
+	})
+}
+
+// Product3 creates an enumerator for the cartesian product of the values in
+// the given enumerators as tuples in lexicographic order, where the third
+// value varies the fastest.
+//
+// The first enumerator is read lazily and the second and third enumerators
+// are read into slices each time the product is iterated.
+func Product3[T1, T2, T3 any](e1 collections.Enumerator[T1], e2 collections.Enumerator[T2], e3 collections.Enumerator[T3]) collections.Enumerator[collections.Tuple3[T1, T2, T3]] {
+	return New(func() collections.Iterator[collections.Tuple3[T1, T2, T3]] {
+		rest := Product2(Enumerate(e2.ToSlice()...), Enumerate(e3.ToSlice()...)).ToSlice()
+		return iterator.Expand(e1.Iterate(), func(first T1) collections.Iterable[collections.Tuple3[T1, T2, T3]] {
+			return func() collections.Iterator[collections.Tuple3[T1, T2, T3]] {
+				return iterator.Select(iterator.Iterate(rest...), func(t collections.Tuple2[T2, T3]) collections.Tuple3[T1, T2, T3] {
+					return tuple3.New(first, t.Value1(), t.Value2())
+				})
+			}
+		})
+	})
+}
+
+// checkSelectCount panics if the given number of values to select is negative.
+// This is checked when the enumerator is created, instead of when it is
+// iterated, so that the panic happens where the enumerator was created.
+func checkSelectCount(k int) {
+	if k < 0 {
+		panic(terror.New(`the number of values to select may not be negative`).
+			With(`k`, k))
+	}
+}
+
+// Permutations creates an enumerator for all the ordered arrangements of
+// the given number of values chosen from the values in the given enumerator.
+// The arrangements are returned in lexicographic order of the value positions.
+// Values are chosen by position so duplicate values will create duplicate arrangements.
+// If k is greater than the number of values then there are no arrangements.
+//
+// The given enumerator is read into a slice each time this is iterated but
+// the arrangements are created lazily, so very large numbers of arrangements
+// can be sampled with methods like `Take` and `AtLeast`.
+// Each returned slice is a new slice which may be kept.
+//
+// This will panic if k is negative.
+func Permutations[T any](e collections.Enumerator[T], k int) collections.Enumerator[[]T] {
+	checkSelectCount(k)
+	return New(func() collections.Iterator[[]T] {
+		return iterator.Permutations(e.ToSlice(), k)
+	})
+}
+
+// Combinations creates an enumerator for all the unordered selections of
+// the given number of values chosen from the values in the given enumerator
+// without repeats. The selections are returned in lexicographic order of the
+// value positions and the values in each selection are in the same order as
+// the given values. Values are chosen by position so duplicate values will
+// create duplicate selections. If k is greater than the number of values
+// then there are no selections.
+//
+// The given enumerator is read into a slice each time this is iterated but
+// the selections are created lazily, so very large numbers of selections
+// can be sampled with methods like `Take` and `AtLeast`.
+// Each returned slice is a new slice which may be kept.
+//
+// This will panic if k is negative.
+func Combinations[T any](e collections.Enumerator[T], k int) collections.Enumerator[[]T] {
+	checkSelectCount(k)
+	return New(func() collections.Iterator[[]T] {
+		return iterator.Combinations(e.ToSlice(), k)
+	})
+}
+
+// CombinationsWithReplacement creates an enumerator for all the unordered
+// selections of the given number of values chosen from the values in the given
+// enumerator where each value may be chosen more than once. The selections are
+// returned in lexicographic order of the value positions and the values in
+// each selection are in the same order as the given values.
+//
+// The given enumerator is read into a slice each time this is iterated but
+// the selections are created lazily, so very large numbers of selections
+// can be sampled with methods like `Take` and `AtLeast`.
+// Each returned slice is a new slice which may be kept.
+//
+// This will panic if k is negative.
+func CombinationsWithReplacement[T any](e collections.Enumerator[T], k int) collections.Enumerator[[]T] {
+	checkSelectCount(k)
+	return New(func() collections.Iterator[[]T] {
+		return iterator.CombinationsWithReplacement(e.ToSlice(), k)
+	})
+}
+
+// PowerSet creates an enumerator for all the subsets of the values in the
+// given enumerator, starting with the empty subset. The subsets are returned
+// in lexicographic order of the value positions and the values in each subset
+// are in the same order as the given values.
+//
+// The given enumerator is read into a slice each time this is iterated but
+// the subsets are created lazily, so very large power sets can be sampled
+// with methods like `Take` and `AtLeast`.
+// Each returned slice is a new slice which may be kept.
+func PowerSet[T any](e collections.Enumerator[T]) collections.Enumerator[[]T] {
+	return New(func() collections.Iterator[[]T] {
+		return iterator.PowerSet(e.ToSlice())
+	})
+}
+
+// OrderBy creates an enumerator which enumerates the values from the given
+// enumerator in ascending order by the keys selected from each value.
+//
+// The sort is stable so values with equal keys keep their original order.
+// The keys are selected only once per value each time the enumerator is iterated.
+// This can take an optional comparer to override the default comparer
+// or to give a comparer if there is no default comparer for the key type.
+// Use `ThenBy` and `ThenByDescending` to add subsequent ordering levels.
+// This will panic if the enumerator is known to be infinite.
+func OrderBy[T, K any](e collections.Enumerator[T], keySelector collections.Selector[T, K], comparer ...comp.Comparer[K]) collections.OrderedEnumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	checkFinite(e, `OrderBy`)
+	cmp := optional.Comparer(comparer)
+	return newOrdered(e, []orderLevel[T]{keyLevel(keySelector, cmp, false)})
+}
+
+// OrderByDescending creates an enumerator which enumerates the values from the given
+// enumerator in descending order by the keys selected from each value.
+//
+// The sort is stable so values with equal keys keep their original order.
+// The keys are selected only once per value each time the enumerator is iterated.
+// This can take an optional comparer to override the default comparer
+// or to give a comparer if there is no default comparer for the key type.
+// Use `ThenBy` and `ThenByDescending` to add subsequent ordering levels.
+// This will panic if the enumerator is known to be infinite.
+func OrderByDescending[T, K any](e collections.Enumerator[T], keySelector collections.Selector[T, K], comparer ...comp.Comparer[K]) collections.OrderedEnumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	checkFinite(e, `OrderBy`)
+	cmp := optional.Comparer(comparer)
+	return newOrdered(e, []orderLevel[T]{keyLevel(keySelector, cmp, true)})
+}
+
+// ThenBy adds a subsequent ascending ordering level to the given ordered enumerator
+// by the keys selected from each value. The new level is only used to order
+// values which are equal for all prior ordering levels.
+//
+// This can take an optional comparer to override the default comparer
+// or to give a comparer if there is no default comparer for the key type.
+func ThenBy[T, K any](o collections.OrderedEnumerator[T], keySelector collections.Selector[T, K], comparer ...comp.Comparer[K]) collections.OrderedEnumerator[T] {
+	return thenByKey(o, keySelector, optional.Comparer(comparer), false)
+}
+
+// ThenByDescending adds a subsequent descending ordering level to the given ordered
+// enumerator by the keys selected from each value. The new level is only used to
+// order values which are equal for all prior ordering levels.
+//
+// This can take an optional comparer to override the default comparer
+// or to give a comparer if there is no default comparer for the key type.
+func ThenByDescending[T, K any](o collections.OrderedEnumerator[T], keySelector collections.Selector[T, K], comparer ...comp.Comparer[K]) collections.OrderedEnumerator[T] {
+	return thenByKey(o, keySelector, optional.Comparer(comparer), true)
+}
+
+func thenByKey[T, K any](o collections.OrderedEnumerator[T], keySelector collections.Selector[T, K], cmp comp.Comparer[K], descending bool) collections.OrderedEnumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	if imp, ok := o.(*orderedImp[T]); ok {
+		return imp.thenBy(keyLevel(keySelector, cmp, descending))
+	}
+
+	// Fallback for other implementations, the keys are selected per comparison.
+	byKey := func(x, y T) int {
+		return cmp(keySelector(x), keySelector(y))
+	}
+	if descending {
+		return o.ThenByDescending(byKey)
+	}
+	return o.ThenBy(byKey)
+}
+
+// Get gets the value at the given index in the given enumerator.
+// Returns zero and false if the index is negative or there are not enough values.
+func Get[T any](e collections.Enumerator[T], index int) (T, bool) {
+	return iterator.Get(e.Iterate(), index)
+}
+
 // Sum gets the sum of all value in the given enumerator
 // and the number of values that were summed.
+// This will panic if the enumerator is known to be infinite.
 func Sum[T utils.NumConstraint](e collections.Enumerator[T]) (T, int) {
+	checkFinite(e, `Sum`)
 	return iterator.Sum(e.Iterate())
 }
 
@@ -224,6 +830,107 @@ func ZipToTuples[TFirst, TSecond any](firsts collections.Enumerator[TFirst], sec
 	return Zip(firsts, seconds, tuple2.New)
 }
 
+// Join creates an enumerator which performs an inner join of the two given enumerators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are not returned.
+//
+// The inner values are read into a hash lookup the first time the returned
+// enumerator is read from, then the outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func Join[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Enumerator[TOuter],
+	inner collections.Enumerator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Enumerator[TOut] {
+	checkJoinArgs(outerKey, innerKey, combiner)
+	return New(func() collections.Iterator[TOut] {
+		return iterator.Join(outer.Iterate(), inner.Iterate(), outerKey, innerKey, combiner)
+	})
+}
+
+// LeftJoin creates an enumerator which performs a left outer join of the two given enumerators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are combined with the zero inner value.
+//
+// The inner values are read into a hash lookup the first time the returned
+// enumerator is read from, then the outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func LeftJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Enumerator[TOuter],
+	inner collections.Enumerator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Enumerator[TOut] {
+	checkJoinArgs(outerKey, innerKey, combiner)
+	return New(func() collections.Iterator[TOut] {
+		return iterator.LeftJoin(outer.Iterate(), inner.Iterate(), outerKey, innerKey, combiner)
+	})
+}
+
+// FullOuterJoin creates an enumerator which performs a full outer join of the two given enumerators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are combined with the zero inner value.
+// After all the outer values, the inner values which did not match any outer value
+// are combined with the zero outer value.
+//
+// The inner values are read into a hash lookup the first time the returned
+// enumerator is read from, then the outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func FullOuterJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Enumerator[TOuter],
+	inner collections.Enumerator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Enumerator[TOut] {
+	checkJoinArgs(outerKey, innerKey, combiner)
+	return New(func() collections.Iterator[TOut] {
+		return iterator.FullOuterJoin(outer.Iterate(), inner.Iterate(), outerKey, innerKey, combiner)
+	})
+}
+
+// GroupJoin creates an enumerator which combines each outer value with an enumerator
+// of all the inner values which have a matching key. Outer values without any
+// matching inner values are combined with an empty enumerator.
+//
+// The inner values are read into a hash lookup the first time the returned
+// enumerator is read from, then the outer values are read only as needed.
+// The results are in the order of the outer values.
+func GroupJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Enumerator[TOuter],
+	inner collections.Enumerator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, collections.Enumerator[TInner], TOut],
+) collections.Enumerator[TOut] {
+	checkJoinArgs(outerKey, innerKey, combiner)
+	grouper := func(value TOuter, group []TInner) TOut {
+		return combiner(value, Enumerate(group...))
+	}
+	return New(func() collections.Iterator[TOut] {
+		return iterator.GroupJoin(outer.Iterate(), inner.Iterate(), outerKey, innerKey, grouper)
+	})
+}
+
+func checkJoinArgs[TOuter, TInner, TKey, TCombiner any](
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner TCombiner,
+) {
+	if utils.IsNil(outerKey) {
+		panic(terror.NilArg(`outerKey`))
+	}
+	if utils.IsNil(innerKey) {
+		panic(terror.NilArg(`innerKey`))
+	}
+	if utils.IsNil(combiner) {
+		panic(terror.NilArg(`combiner`))
+	}
+}
+
 // Interweave will pull values from each enumerator, one at a time,
 // and return them in the cycling order as an enumerator.
 // When an enumerator runs out the remaining will interweave until all are empty.
@@ -283,3 +990,101 @@ func Subtract[T comparable](left, right collections.Enumerator[T]) collections.E
 		return iterator.Subtract(left.Iterate(), right.Iterate())
 	})
 }
+
+// DistinctBy creates an enumerator that returns only the values with unique keys.
+// The key for each value is determined with the given key selector and
+// the first value with each key is returned.
+//
+// This allows values which are not comparable to be made unique
+// by a comparable key selected from each value.
+func DistinctBy[T any, K comparable](e collections.Enumerator[T], keySelector collections.Selector[T, K]) collections.Enumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	return New(func() collections.Iterator[T] {
+		return iterator.DistinctBy(e.Iterate(), keySelector)
+	})
+}
+
+// DistinctFunc creates an enumerator that returns only the unique values.
+// Uniqueness is determined with the given hasher
+// so that the values do not need to be comparable.
+func DistinctFunc[T any](e collections.Enumerator[T], hasher comp.Hasher[T]) collections.Enumerator[T] {
+	checkHasher(hasher)
+	return New(func() collections.Iterator[T] {
+		return iterator.DistinctFunc(e.Iterate(), hasher)
+	})
+}
+
+// UnionBy creates an enumerator that is the union of the two enumerators
+// where the values are unique by the key selected from each value.
+// The first value with each key is returned.
+func UnionBy[T any, K comparable](left, right collections.Enumerator[T], keySelector collections.Selector[T, K]) collections.Enumerator[T] {
+	return DistinctBy(left.Concat(right), keySelector)
+}
+
+// UnionFunc creates an enumerator that is the union of the two enumerators
+// where the values are unique as determined with the given hasher.
+func UnionFunc[T any](left, right collections.Enumerator[T], hasher comp.Hasher[T]) collections.Enumerator[T] {
+	return DistinctFunc(left.Concat(right), hasher)
+}
+
+// IntersectBy creates an enumerator that contains only the values from the given enumerator
+// which have a key that matches the key of any value in the other enumerator.
+// The key for each value is determined with the given key selector.
+//
+// The given enumerator determines the order and if there are repeats in the result.
+func IntersectBy[T any, K comparable](e, other collections.Enumerator[T], keySelector collections.Selector[T, K]) collections.Enumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	return New(func() collections.Iterator[T] {
+		return iterator.IntersectBy(e.Iterate(), other.Iterate(), keySelector)
+	})
+}
+
+// IntersectFunc creates an enumerator that contains only the values from the given
+// enumerator which are equal to any value in the other enumerator.
+// Equality is determined with the given hasher
+// so that the values do not need to be comparable.
+//
+// The given enumerator determines the order and if there are repeats in the result.
+func IntersectFunc[T any](e, other collections.Enumerator[T], hasher comp.Hasher[T]) collections.Enumerator[T] {
+	checkHasher(hasher)
+	return New(func() collections.Iterator[T] {
+		return iterator.IntersectFunc(e.Iterate(), other.Iterate(), hasher)
+	})
+}
+
+// ExceptBy creates an enumerator that contains only the values from the given enumerator
+// which have a key that does not match the key of any value in the other enumerator.
+// The key for each value is determined with the given key selector.
+//
+// The given enumerator determines the order and if there are repeats in the result.
+func ExceptBy[T any, K comparable](e, other collections.Enumerator[T], keySelector collections.Selector[T, K]) collections.Enumerator[T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	return New(func() collections.Iterator[T] {
+		return iterator.ExceptBy(e.Iterate(), other.Iterate(), keySelector)
+	})
+}
+
+// ExceptFunc creates an enumerator that contains only the values from the given
+// enumerator which are not equal to any value in the other enumerator.
+// Equality is determined with the given hasher
+// so that the values do not need to be comparable.
+//
+// The given enumerator determines the order and if there are repeats in the result.
+func ExceptFunc[T any](e, other collections.Enumerator[T], hasher comp.Hasher[T]) collections.Enumerator[T] {
+	checkHasher(hasher)
+	return New(func() collections.Iterator[T] {
+		return iterator.ExceptFunc(e.Iterate(), other.Iterate(), hasher)
+	})
+}
+
+func checkHasher[T any](hasher comp.Hasher[T]) {
+	if utils.IsNil(hasher) {
+		panic(terror.NilArg(`hasher`))
+	}
+}
@@ -3,16 +3,22 @@ package enumerator
 import (
 	"bytes"
 	"cmp"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math"
 	"strconv"
 	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
+	"unicode/utf8"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
@@ -95,6 +101,163 @@ func Test_Enumerator_Repeat(t *testing.T) {
 	checkEqual(t, true, e.Empty())
 }
 
+func Test_Enumerator_FromChan(t *testing.T) {
+	ch := make(chan string, 5)
+	ch <- `cat`
+	ch <- `dog`
+	ch <- `bat`
+	close(ch)
+	e := FromChan(ch)
+	checkEqual(t, []string{`cat`, `dog`, `bat`}, e.ToSlice())
+	checkEqual(t, []string{}, e.ToSlice()) // can not be re-iterated
+
+	ch = make(chan string, 5)
+	ch <- `cat`
+	ch <- `dog`
+	ch <- `bat`
+	close(ch)
+	e = FromChan(ch)
+	checkEqual(t, []string{`cat`}, e.Take(1).ToSlice())
+	checkEqual(t, []string{`dog`, `bat`}, e.ToSlice()) // continues where it stopped
+
+	ch = make(chan string, 5)
+	ch <- `cat`
+	ch <- `dog`
+	close(ch)
+	e = FromChan(ch).Buffered()
+	checkEqual(t, []string{`cat`, `dog`}, e.ToSlice())
+	checkEqual(t, []string{`cat`, `dog`}, e.ToSlice()) // buffered can be re-iterated
+}
+
+func Test_Enumerator_FromChanCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			ch <- i
+		}
+		cancel()
+	}()
+	checkEqual(t, []int{1, 2, 3}, FromChanCtx(ctx, ch).ToSlice())
+
+	var nilCtx context.Context
+	checkPanic(t, `argument may not be nil {name: ctx}`, func() {
+		FromChanCtx(nilCtx, ch)
+	})
+}
+
+func Test_Enumerator_ToChan(t *testing.T) {
+	ch, wait := ToChan(context.Background(), Range(1, 5), 0)
+	checkEqual(t, []int{1, 2, 3, 4, 5}, FromChan(ch).ToSlice())
+	wait()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, wait = ToChan(ctx, Range(1, 1000), 1)
+	checkEqual(t, []int{1, 2, 3}, FromChan(ch).Take(3).ToSlice())
+	cancel()
+	checkEqual(t, true, FromChan(ch).AtMost(2)) // producer stops after cancel
+	wait()
+
+	ch, wait = ToChan(context.Background(), Select(Range(1, 5), func(v int) int {
+		if v > 2 {
+			panic(`oops`)
+		}
+		return v
+	}), 0)
+	checkEqual(t, []int{1, 2}, FromChan(ch).ToSlice())
+	checkPanic(t, `producer failed: recovered panic {recovered: oops}`, wait)
+
+	var nilCtx context.Context
+	checkPanic(t, `argument may not be nil {name: ctx}`, func() {
+		ToChan(nilCtx, Range(1, 5), 0)
+	})
+}
+
+func Test_Enumerator_WithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	e := WithContext(ctx, Generate(func() int { return 3 }))
+	checkEqual(t, false, IsInfinite(e))
+	checkEqual(t, []int{3, 3}, e.Take(2).ToSlice())
+	cancel()
+	checkEqual(t, []int{}, e.ToSlice())
+
+	var nilCtx context.Context
+	checkPanic(t, `argument may not be nil {name: ctx}`, func() {
+		WithContext(nilCtx, Range(1, 3))
+	})
+}
+
+func Test_Enumerator_ForeachCtx(t *testing.T) {
+	values := []int{}
+	err := ForeachCtx(context.Background(), Range(1, 4), func(value int) {
+		values = append(values, value)
+	})
+	checkEqual(t, nil, err)
+	checkEqual(t, []int{1, 2, 3, 4}, values)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	values = []int{}
+	err = ForeachCtx(ctx, Range(1, 10), func(value int) {
+		values = append(values, value)
+		if value == 3 {
+			cancel()
+		}
+	})
+	checkEqual(t, `enumeration canceled {index: 3}: context canceled`, err.Error())
+	checkEqual(t, true, errors.Is(err, context.Canceled))
+	checkEqual(t, []int{1, 2, 3}, values)
+
+	checkPanic(t, `argument may not be nil {name: m}`, func() {
+		_ = ForeachCtx[int](context.Background(), Range(1, 3), nil)
+	})
+}
+
+func Test_Enumerator_DoUntilErrorCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := DoUntilErrorCtx(ctx, Range(1, 10), func(value int) error {
+		if value == 2 {
+			return errors.New(`stop`)
+		}
+		return nil
+	})
+	checkEqual(t, `stop`, err.Error())
+
+	cancel()
+	err = DoUntilErrorCtx(ctx, Range(1, 10), func(int) error { return nil })
+	checkEqual(t, `enumeration canceled {index: 0}: context canceled`, err.Error())
+
+	checkPanic(t, `argument may not be nil {name: s}`, func() {
+		_ = DoUntilErrorCtx[int](context.Background(), Range(1, 3), nil)
+	})
+}
+
+func Test_Enumerator_ToSliceCtx(t *testing.T) {
+	values, err := ToSliceCtx(context.Background(), Range(1, 4))
+	checkEqual(t, nil, err)
+	checkEqual(t, []int{1, 2, 3, 4}, values)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	closed := 0
+	e := New(func() collections.Iterator[int] {
+		values := Generate(func() int {
+			time.Sleep(time.Millisecond)
+			return 1
+		}).Iterate()
+		return iterator.NewClosable(func() (int, bool) {
+			values.Next()
+			return values.Current(), true
+		}, func() error {
+			closed++
+			return nil
+		})
+	})
+	values, err = ToSliceCtx(ctx, e)
+	checkEqual(t, true, errors.Is(err, context.DeadlineExceeded))
+	checkEqual(t, true, len(values) > 0)
+	checkEqual(t, 1, closed)
+}
+
 func Test_Enumerator_Split(t *testing.T) {
 	e := Split(`Cat dog hot cold mouse`, ` `)
 	checkEqual(t, []string{`Cat`, `dog`, `hot`, `cold`, `mouse`}, e.ToSlice())
@@ -154,6 +317,87 @@ func Test_Enumerator_Lines(t *testing.T) {
 		Lines("Cat\n\rdog\r\rhot\r\ncold\u2029mouse").ToSlice())
 }
 
+func Test_Enumerator_ReaderLines(t *testing.T) {
+	for _, s := range []string{
+		"Cat\ndog\n\nhot\ncold\nmouse",
+		"Cat\n\rdog\r\rhot\r\ncold\u2029mouse",
+		"Cat\r\n", "\r\n", "\n\n", "", "Cat",
+	} {
+		exp := Lines(s).ToSlice()
+		checkEqual(t, exp, ReaderLines(strings.NewReader(s)).ToSlice())
+		checkEqual(t, exp, ReaderLines(iotest.OneByteReader(strings.NewReader(s))).ToSlice())
+	}
+
+	// A seeker is re-iterated from the position it was first iterated at.
+	r := strings.NewReader("skip\nCat\ndog")
+	_, _ = r.Seek(5, io.SeekStart)
+	e := ReaderLines(r)
+	checkEqual(t, []string{`Cat`, `dog`}, e.ToSlice())
+	checkEqual(t, []string{`Cat`, `dog`}, e.ToSlice())
+	first, _ := e.First()
+	checkEqual(t, `Cat`, first)
+	checkLength(t, 2, e)
+
+	// A non-seeker continues where the prior iteration stopped.
+	e = ReaderLines(iotest.OneByteReader(strings.NewReader("Cat\ndog\nhot")))
+	checkEqual(t, []string{`Cat`}, e.Take(1).ToSlice())
+	checkEqual(t, []string{`dog`, `hot`}, e.ToSlice())
+	checkEqual(t, []string{}, e.ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: r}`, func() {
+		ReaderLines(nil)
+	})
+}
+
+func Test_Enumerator_ReaderLines_Errors(t *testing.T) {
+	e := ReaderLines(strings.NewReader("Cat\ndog\nhippopotamus\nhot"), 8)
+	checkEqual(t, []string{`Cat`, `dog`}, e.Take(2).ToSlice())
+	checkPanic(t, `failed to read from reader {index: 2}: `+
+		`token is longer than the maximum token size {max token size: 8}: `+
+		`bufio.Scanner: token too long`, func() {
+		e.ToSlice()
+	})
+
+	r := io.MultiReader(strings.NewReader("Cat\ndog\n"), iotest.ErrReader(errors.New(`disk failure`)))
+	checkPanic(t, `failed to read from reader {index: 2}: disk failure`, func() {
+		ReaderLines(r).ToSlice()
+	})
+
+	checkPanic(t, `invalid number of arguments {count: 2, maximum: 1, usage: max token size}`, func() {
+		ReaderLines(strings.NewReader(``), 8, 9)
+	})
+}
+
+func Test_Enumerator_ReaderSplit(t *testing.T) {
+	sep := func(part string) (int, int) {
+		return strings.Index(part, `, `), 2
+	}
+	for _, s := range []string{`Cat, dog, , hot`, `Cat, `, `, `, `,`, ``} {
+		exp := SplitFunc(s, sep).ToSlice()
+		checkEqual(t, exp, ReaderSplit(strings.NewReader(s), sep).ToSlice())
+		checkEqual(t, exp, ReaderSplit(iotest.OneByteReader(strings.NewReader(s)), sep).ToSlice())
+	}
+
+	checkPanic(t, `argument may not be nil {name: separator}`, func() {
+		ReaderSplit(strings.NewReader(``), nil)
+	})
+}
+
+func Test_Enumerator_ReaderRunes(t *testing.T) {
+	e := ReaderRunes(strings.NewReader("Cat\u2029犬\xFF"))
+	checkEqual(t, []rune{'C', 'a', 't', '\u2029', '犬', utf8.RuneError}, e.ToSlice())
+	checkLength(t, 6, e)
+
+	r := io.MultiReader(strings.NewReader(`Cat`), iotest.ErrReader(errors.New(`disk failure`)))
+	checkPanic(t, `failed to read from reader {index: 3}: disk failure`, func() {
+		ReaderRunes(r).ToSlice()
+	})
+
+	checkPanic(t, `argument may not be nil {name: r}`, func() {
+		ReaderRunes(nil)
+	})
+}
+
 func Test_Enumerator_Error(t *testing.T) {
 	e := Errors(fmt.Errorf(`%w-%w-%w`, terror.New(`One`, errors.New(`Two`)), errors.New(`Three`), terror.New(`Four`)))
 	checkEqual(t, []string{`One: Two-Three-Four`, `One: Two`, `Two`, `Three`, `Four`}, e.Strings().ToSlice())
@@ -195,6 +439,22 @@ func Test_Enumerator_Reduce(t *testing.T) {
 	checkEqual(t, []int{5, 3, 5, 4}, values)
 }
 
+func Test_Enumerator_Get(t *testing.T) {
+	e := Enumerate(`horse`, `cat`, `mouse`, `wolf`)
+	value, ok := Get(e, 2)
+	checkEqual(t, `mouse`, value)
+	checkEqual(t, true, ok)
+
+	value, ok = Get(e.Where(func(s string) bool { return len(s) > 3 }), 2)
+	checkEqual(t, `wolf`, value)
+	checkEqual(t, true, ok)
+
+	_, ok = Get(e, 4)
+	checkEqual(t, false, ok)
+	_, ok = Get(e, -1)
+	checkEqual(t, false, ok)
+}
+
 func Test_Enumerator_Merge(t *testing.T) {
 	e1 := Enumerate(`horse`, `cat`, `mouse`, `wolf`)
 	value := e1.Merge(func(s, prior string) string {
@@ -262,6 +522,236 @@ func Test_Enumerator_Chunk(t *testing.T) {
 	checkLength(t, 3, e3)
 }
 
+func Test_Enumerator_Shapers(t *testing.T) {
+	e := Enumerate(1, 3, 2, 4, 6, 5)
+	running := Scan(e, 0, func(value, prior int) int { return value + prior })
+	checkEqual(t, []int{1, 4, 6, 10, 16, 21}, running.ToSlice())
+	checkEqual(t, []int{1, 4, 6, 10, 16, 21}, running.ToSlice())
+
+	diffs := Select(Pairwise(e), func(p collections.Tuple2[int, int]) int {
+		return p.Value2() - p.Value1()
+	})
+	checkEqual(t, []int{2, -1, 2, 2, -1}, diffs.ToSlice())
+
+	checkEqual(t, [][]int{{1}, {3, 2, 4}, {6, 5}},
+		SplitWhen(e, func(value int) bool { return value%3 == 0 }).ToSlice())
+	checkEqual(t, [][]int{{1, 3}, {2, 4, 6}, {5}},
+		ChunkBy(e, func(value int) bool { return value%2 == 0 }).ToSlice())
+	checkEqual(t, []int{1, 0, 3, 0, 2}, Intersperse(e.Take(3), 0).ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: reducer}`, func() {
+		Scan[int, int](e, 0, nil)
+	})
+	checkPanic(t, `argument may not be nil {name: predicate}`, func() {
+		SplitWhen(e, nil)
+	})
+	checkPanic(t, `argument may not be nil {name: keySelector}`, func() {
+		ChunkBy[int, int](e, nil)
+	})
+}
+
+func Test_Enumerator_Partition(t *testing.T) {
+	iterCreated, read := 0, 0
+	source := New(func() collections.Iterator[int] {
+		iterCreated++
+		return iterator.Select(iterator.Range(1, 8), func(value int) int {
+			read++
+			return value
+		})
+	})
+	evens, odds := Partition(source, func(value int) bool { return value%2 == 0 })
+	checkEqual(t, 0, iterCreated)
+
+	first, _ := evens.First()
+	checkEqual(t, 2, first)
+	checkEqual(t, 2, read)
+
+	checkEqual(t, []int{1, 3, 5, 7}, odds.ToSlice())
+	checkEqual(t, 8, read)
+	checkEqual(t, []int{2, 4, 6, 8}, evens.ToSlice())
+	checkEqual(t, []int{1, 3, 5, 7}, odds.ToSlice())
+	checkEqual(t, 1, iterCreated)
+	checkEqual(t, 8, read)
+
+	checkPanic(t, `argument may not be nil {name: predicate}`, func() {
+		Partition(source, nil)
+	})
+}
+
+func Test_Enumerator_Combinatorics(t *testing.T) {
+	e := Product(Enumerate(`a`, `b`), Enumerate(`x`, `y`))
+	checkEqual(t, [][]string{{`a`, `x`}, {`a`, `y`}, {`b`, `x`}, {`b`, `y`}}, e.ToSlice())
+	checkLength(t, 4, e)
+
+	e2 := Product2(Range(1, 2), Enumerate(`x`, `y`))
+	checkEqual(t, `[[1, x] [1, y] [2, x] [2, y]]`, fmt.Sprint(e2.ToSlice()))
+
+	e3 := Product3(Range(1, 2), Enumerate(`x`), Enumerate(true, false))
+	checkEqual(t, `[[1, x, true] [1, x, false] [2, x, true] [2, x, false]]`, fmt.Sprint(e3.ToSlice()))
+
+	checkEqual(t, [][]int{{1, 2}, {1, 3}, {2, 1}, {2, 3}, {3, 1}, {3, 2}},
+		Permutations(Range(1, 3), 2).ToSlice())
+	checkEqual(t, [][]int{{1, 2}, {1, 3}, {2, 3}},
+		Combinations(Range(1, 3), 2).ToSlice())
+	checkEqual(t, [][]int{{1, 1}, {1, 2}, {2, 2}},
+		CombinationsWithReplacement(Range(1, 2), 2).ToSlice())
+	checkEqual(t, [][]int{{}, {1}, {1, 2}, {2}},
+		PowerSet(Range(1, 2)).ToSlice())
+
+	// Huge spaces can be sampled without creating every value.
+	huge := Permutations(Range(0, 20), 20)
+	checkEqual(t, [][]int{
+		Range(0, 20).ToSlice(),
+		Enumerate(0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 19, 18).ToSlice(),
+	}, huge.Take(2).ToSlice())
+	checkEqual(t, true, huge.AtLeast(1000))
+	checkEqual(t, false, huge.AtMost(1000))
+	checkEqual(t, false, PowerSet(Range(0, 64)).AtMost(10))
+	checkEqual(t, false, Product(Range(0, 1000), Range(0, 1000), Range(0, 1000)).AtMost(10))
+
+	checkPanic(t, `the number of values to select may not be negative {k: -2}`, func() {
+		Combinations(Range(1, 3), -2)
+	})
+}
+
+func Test_Enumerator_Unfold(t *testing.T) {
+	fib := Unfold(tuple2.New(0, 1), func(state collections.Tuple2[int, int]) (int, collections.Tuple2[int, int], bool) {
+		a, b := state.Values()
+		return a, tuple2.New(b, a+b), a < 50
+	})
+	checkEqual(t, []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34}, fib.ToSlice())
+	checkLength(t, 10, fib)
+	checkEqual(t, false, IsInfinite(fib))
+
+	checkPanic(t, `argument may not be nil {name: step}`, func() {
+		Unfold[int, int](0, nil)
+	})
+}
+
+func Test_Enumerator_Generate(t *testing.T) {
+	count := 0
+	e := Generate(func() int {
+		count++
+		return count * count
+	})
+	checkEqual(t, true, IsInfinite(e))
+	checkEqual(t, []int{1, 4, 9}, e.Take(3).ToSlice())
+	checkEqual(t, false, IsInfinite(e.Take(3)))
+	checkEqual(t, []int{16, 25}, e.Take(2).ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: generator}`, func() {
+		Generate[int](nil)
+	})
+}
+
+func Test_Enumerator_Iterate(t *testing.T) {
+	e := Iterate(1, func(value int) int { return value * 2 })
+	checkEqual(t, []int{1, 2, 4, 8, 16}, e.Take(5).ToSlice())
+	checkEqual(t, []int{1, 2, 4, 8, 16}, e.TakeWhile(func(value int) bool { return value < 20 }).ToSlice())
+	checkEqual(t, 10, e.StepsUntil(func(value int) bool { return value > 1000 }))
+
+	checkPanic(t, `argument may not be nil {name: next}`, func() {
+		Iterate[int](1, nil)
+	})
+}
+
+func Test_Enumerator_Cycle(t *testing.T) {
+	e := Cycle(Range(1, 3))
+	checkEqual(t, []int{1, 2, 3, 1, 2, 3, 1}, e.Take(7).ToSlice())
+	checkEqual(t, true, e.AtLeast(100))
+	checkEqual(t, []int{}, Cycle(Range(1, 0)).Take(3).ToSlice())
+
+	// A cycle of no values is still known to be infinite.
+	empty := Cycle(Range(1, 0))
+	checkEqual(t, true, empty.Empty())
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Count}`, func() { empty.Count() })
+}
+
+func Test_Enumerator_Close(t *testing.T) {
+	closed := 0
+	e := New(func() collections.Iterator[int] {
+		values := Range(1, 5).Iterate()
+		return iterator.NewClosable(func() (int, bool) {
+			if values.Next() {
+				return values.Current(), true
+			}
+			return 0, false
+		}, func() error {
+			closed++
+			return nil
+		})
+	})
+
+	checkEqual(t, true, e.Any(func(v int) bool { return v > 2 }))
+	checkEqual(t, 1, closed)
+	checkEqual(t, []int{1, 2}, e.TakeWhile(func(v int) bool { return v < 3 }).ToSlice())
+	checkEqual(t, 2, closed)
+	checkEqual(t, []int{1, 2, 3, 4, 5, 1, 2}, Cycle(e).Take(7).ToSlice())
+	checkEqual(t, 4, closed)
+
+	for v := range e.Seq() {
+		if v > 1 {
+			break
+		}
+	}
+	checkEqual(t, 5, closed)
+
+	// The shared source is only closed once it has been read to the end.
+	b := e.Buffered()
+	checkEqual(t, []int{1, 2}, b.Take(2).ToSlice())
+	checkEqual(t, 5, closed)
+	checkEqual(t, []int{1, 2, 3, 4, 5}, b.ToSlice())
+	checkEqual(t, 6, closed)
+
+	evens, odds := Partition(e, func(v int) bool { return v%2 == 0 })
+	first, _ := evens.First()
+	checkEqual(t, 2, first)
+	checkEqual(t, 6, closed)
+	checkEqual(t, []int{1, 3, 5}, odds.ToSlice())
+	checkEqual(t, 7, closed)
+}
+
+func Test_Enumerator_Infinite(t *testing.T) {
+	e := Iterate(1, func(value int) int { return value + 1 })
+
+	// Operators which don't limit the values keep the enumerator infinite.
+	infinite := []collections.Enumerator[int]{
+		e.Where(func(value int) bool { return value%2 == 0 }),
+		e.WhereNot(func(value int) bool { return value%2 == 0 }),
+		e.Skip(3),
+		e.SkipWhile(func(value int) bool { return value < 3 }),
+		e.Replace(func(value int) int { return -value }),
+		e.Append(0),
+		e.Buffered(),
+		Enumerate(1, 2).Concat(e),
+		Select(e, func(value int) int { return value * 3 }),
+		Scan(e, 0, func(value, prior int) int { return value + prior }),
+		Intersperse(e, 0),
+	}
+	for i, inf := range infinite {
+		if !IsInfinite(inf) {
+			t.Errorf(`expected infinite enumerator #%d`, i)
+		}
+	}
+	checkEqual(t, true, IsInfinite(e.Strings()))
+	checkEqual(t, true, IsInfinite(Pairwise(e)))
+	checkEqual(t, []int{2, 4, 6}, infinite[0].Take(3).ToSlice())
+	checkEqual(t, []string{`1`, `2`}, e.Strings().Take(2).ToSlice())
+
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Count}`, func() { e.Count() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Last}`, func() { e.Last() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Sort}`, func() { e.Sort() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Reverse}`, func() { e.Reverse() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: ToSlice}`, func() { e.Skip(2).ToSlice() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Foreach}`, func() { e.Foreach(func(int) {}) })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Max}`, func() { e.Max() })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Join}`, func() { e.Join(`, `) })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: Sum}`, func() { Sum(e) })
+	checkPanic(t, `may not perform action on an infinite enumerator {action: OrderBy}`, func() {
+		OrderBy(e, func(value int) int { return value })
+	})
+}
+
 func Test_Enumerator_Max(t *testing.T) {
 	checkEqual(t, `wolf`, Enumerate(`horse`, `wolf`, `cat`, `mouse`).Max())
 	checkEqual(t, `wolf`, Enumerate(`Wolf`, `wolf`, `WOLF`).Max())
@@ -349,6 +839,123 @@ func Test_Enumerator_Intersection_Union_Subtract(t *testing.T) {
 	checkEqual(t, []int{7, 9}, Subtract(e2, e1).ToSlice())
 }
 
+type taggedValue struct {
+	name string
+	tags []string
+}
+
+func (v taggedValue) String() string {
+	return v.name + `[` + strings.Join(v.tags, `,`) + `]`
+}
+
+func Test_Enumerator_DistinctBy_UnionBy_IntersectBy_ExceptBy(t *testing.T) {
+	byName := func(v taggedValue) string { return v.name }
+	e1 := Enumerate(
+		taggedValue{name: `cat`, tags: []string{`pet`}},
+		taggedValue{name: `dog`, tags: []string{`pet`, `loud`}},
+		taggedValue{name: `cat`, tags: []string{`feline`}},
+		taggedValue{name: `owl`, tags: nil})
+	e2 := Enumerate(
+		taggedValue{name: `owl`, tags: []string{`bird`}},
+		taggedValue{name: `eel`, tags: nil},
+		taggedValue{name: `dog`, tags: nil})
+
+	checkEqual(t, []string{`cat[pet]`, `dog[pet,loud]`, `owl[]`}, DistinctBy(e1, byName).Strings().ToSlice())
+	checkEqual(t, []string{`cat[pet]`, `dog[pet,loud]`, `owl[]`, `eel[]`}, UnionBy(e1, e2, byName).Strings().ToSlice())
+	checkEqual(t, []string{`owl[bird]`, `eel[]`, `dog[]`, `cat[pet]`}, UnionBy(e2, e1, byName).Strings().ToSlice())
+	checkEqual(t, []string{`dog[pet,loud]`, `owl[]`}, IntersectBy(e1, e2, byName).Strings().ToSlice())
+	checkEqual(t, []string{`owl[bird]`, `dog[]`}, IntersectBy(e2, e1, byName).Strings().ToSlice())
+	checkEqual(t, []string{`cat[pet]`, `cat[feline]`}, ExceptBy(e1, e2, byName).Strings().ToSlice())
+	checkEqual(t, []string{`eel[]`}, ExceptBy(e2, e1, byName).Strings().ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: keySelector}`, func() {
+		DistinctBy[int, int](Range(1, 3), nil)
+	})
+}
+
+func Test_Enumerator_DistinctFunc_UnionFunc_IntersectFunc_ExceptFunc(t *testing.T) {
+	equal := func(x, y collections.Tuple2[string, int]) bool {
+		return x.Value1() == y.Value1() && x.Value2() == y.Value2()
+	}
+	hasher := comp.NewHasher(func(v collections.Tuple2[string, int]) uint64 {
+		return uint64(len(v.Value1()) + v.Value2())
+	}, equal)
+	e1 := ZipToTuples(Enumerate(`a`, `b`, `a`, `c`, `ab`), Enumerate(1, 2, 1, 3, 0))
+	e2 := ZipToTuples(Enumerate(`c`, `d`, `a`), Enumerate(3, 4, 2))
+
+	checkEqual(t, []string{`[a, 1]`, `[b, 2]`, `[c, 3]`, `[ab, 0]`}, DistinctFunc(e1, hasher).Strings().ToSlice())
+	checkEqual(t, []string{`[a, 1]`, `[b, 2]`, `[c, 3]`, `[ab, 0]`, `[d, 4]`, `[a, 2]`}, UnionFunc(e1, e2, hasher).Strings().ToSlice())
+	checkEqual(t, []string{`[c, 3]`}, IntersectFunc(e1, e2, hasher).Strings().ToSlice())
+	checkEqual(t, []string{`[a, 1]`, `[b, 2]`, `[a, 1]`, `[ab, 0]`}, ExceptFunc(e1, e2, hasher).Strings().ToSlice())
+	checkEqual(t, []string{`[d, 4]`, `[a, 2]`}, ExceptFunc(e2, e1, hasher).Strings().ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: hasher}`, func() {
+		DistinctFunc(e1, nil)
+	})
+	checkPanic(t, `argument may not be nil {name: hasher}`, func() {
+		ExceptFunc(e1, e2, nil)
+	})
+}
+
+type joinPerson struct {
+	id   int
+	name string
+}
+
+type joinDiscovery struct {
+	personID int
+	name     string
+}
+
+func joinData() (collections.Enumerator[joinPerson], collections.Enumerator[joinDiscovery]) {
+	people := Enumerate(
+		joinPerson{id: 1, name: `Curie`},
+		joinPerson{id: 2, name: `Franklin`},
+		joinPerson{id: 3, name: `Lovelace`},
+		joinPerson{id: 4, name: `Meitner`})
+	discoveries := Enumerate(
+		joinDiscovery{personID: 1, name: `Polonium`},
+		joinDiscovery{personID: 4, name: `Fission`},
+		joinDiscovery{personID: 1, name: `Radium`},
+		joinDiscovery{personID: 5, name: `Pulsars`},
+		joinDiscovery{personID: 2, name: `DNA Structure`})
+	return people, discoveries
+}
+
+func Test_Enumerator_Joins(t *testing.T) {
+	people, discoveries := joinData()
+	personID := func(p joinPerson) int { return p.id }
+	discoveryID := func(d joinDiscovery) int { return d.personID }
+	combine := func(p joinPerson, d joinDiscovery) string {
+		return p.name + `:` + d.name
+	}
+
+	e := Join(people, discoveries, personID, discoveryID, combine)
+	checkEqual(t, []string{`Curie:Polonium`, `Curie:Radium`, `Franklin:DNA Structure`, `Meitner:Fission`}, e.ToSlice())
+	checkLength(t, 4, e)
+
+	e = LeftJoin(people, discoveries, personID, discoveryID, combine)
+	checkEqual(t, []string{`Curie:Polonium`, `Curie:Radium`, `Franklin:DNA Structure`, `Lovelace:`, `Meitner:Fission`}, e.ToSlice())
+
+	e = FullOuterJoin(people, discoveries, personID, discoveryID, combine)
+	checkEqual(t, []string{`Curie:Polonium`, `Curie:Radium`, `Franklin:DNA Structure`, `Lovelace:`, `Meitner:Fission`, `:Pulsars`}, e.ToSlice())
+
+	e = GroupJoin(people, discoveries, personID, discoveryID, func(p joinPerson, ds collections.Enumerator[joinDiscovery]) string {
+		return p.name + `:` + Select(ds, func(d joinDiscovery) string { return d.name }).Join(`,`)
+	})
+	checkEqual(t, []string{`Curie:Polonium,Radium`, `Franklin:DNA Structure`, `Lovelace:`, `Meitner:Fission`}, e.ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: outerKey}`, func() {
+		Join(people, discoveries, nil, discoveryID, combine)
+	})
+	checkPanic(t, `argument may not be nil {name: innerKey}`, func() {
+		LeftJoin(people, discoveries, personID, nil, combine)
+	})
+	checkPanic(t, `argument may not be nil {name: combiner}`, func() {
+		FullOuterJoin[joinPerson, joinDiscovery, int, string](people, discoveries, personID, discoveryID, nil)
+	})
+}
+
 func Test_Enumerator_Zip(t *testing.T) {
 	e1 := Enumerate(`cat`, `bat`, `wolf`, `hat`, `mouse`, `dog`)
 	checkLength(t, 6, e1)
@@ -461,6 +1068,91 @@ func Test_Enumerator_Sort(t *testing.T) {
 	})
 }
 
+type orderPerson struct {
+	first string
+	last  string
+	id    int
+}
+
+func (p orderPerson) String() string {
+	return fmt.Sprintf(`%s %s %d`, p.first, p.last, p.id)
+}
+
+func Test_Enumerator_OrderBy(t *testing.T) {
+	people := Enumerate(
+		orderPerson{first: `Ada`, last: `Lovelace`, id: 4},
+		orderPerson{first: `Grace`, last: `Hopper`, id: 2},
+		orderPerson{first: `Alan`, last: `Turing`, id: 7},
+		orderPerson{first: `Ada`, last: `Hopper`, id: 3},
+		orderPerson{first: `Grace`, last: `Hopper`, id: 1},
+		orderPerson{first: `Ada`, last: `Lovelace`, id: 5})
+
+	byLast := func(p orderPerson) string { return p.last }
+	byFirst := func(p orderPerson) string { return p.first }
+	byID := func(p orderPerson) int { return p.id }
+
+	e1 := OrderBy(people, byLast)
+	checkEqual(t, []string{
+		`Grace Hopper 2`, `Ada Hopper 3`, `Grace Hopper 1`,
+		`Ada Lovelace 4`, `Ada Lovelace 5`, `Alan Turing 7`,
+	}, e1.Strings().ToSlice())
+	checkLength(t, 6, e1)
+
+	e2 := ThenBy(ThenByDescending(OrderBy(people, byLast), byFirst), byID)
+	checkEqual(t, []string{
+		`Grace Hopper 1`, `Grace Hopper 2`, `Ada Hopper 3`,
+		`Ada Lovelace 4`, `Ada Lovelace 5`, `Alan Turing 7`,
+	}, e2.Strings().ToSlice())
+
+	e3 := ThenByDescending(OrderByDescending(people, byFirst), byID)
+	checkEqual(t, []string{
+		`Grace Hopper 2`, `Grace Hopper 1`, `Alan Turing 7`,
+		`Ada Lovelace 5`, `Ada Lovelace 4`, `Ada Hopper 3`,
+	}, e3.Strings().ToSlice())
+
+	e4 := OrderBy(people, byFirst).ThenByDescending(func(x, y orderPerson) int {
+		return x.id - y.id
+	})
+	checkEqual(t, []string{
+		`Ada Lovelace 5`, `Ada Lovelace 4`, `Ada Hopper 3`,
+		`Alan Turing 7`, `Grace Hopper 2`, `Grace Hopper 1`,
+	}, e4.Strings().ToSlice())
+
+	e5 := OrderBy(Enumerate(`cat`, `horse`, `wolf`, `elephant`, `mouse`, `dog`), func(s string) int {
+		return len(s)
+	}).ThenBy()
+	checkEqual(t, []string{`cat`, `dog`, `wolf`, `horse`, `mouse`, `elephant`}, e5.ToSlice())
+	checkEqual(t, []string{`cat`, `dog`, `wolf`, `horse`, `mouse`, `elephant`}, e5.ToSlice())
+
+	checkPanic(t, `argument may not be nil {name: keySelector}`, func() {
+		OrderBy[int, int](Range(1, 3), nil)
+	})
+	checkPanic(t, `must provide a comparer to compare this type {type: []int}`, func() {
+		OrderBy(Range(1, 3), func(i int) []int { return []int{i} })
+	})
+}
+
+func Test_Enumerator_OrderBy_SelectsKeysOnce(t *testing.T) {
+	calls := 0
+	e := OrderBy(Range(0, 10), func(i int) int {
+		calls++
+		return (i * 7) % 10
+	})
+	checkEqual(t, []int{0, 3, 6, 9, 2, 5, 8, 1, 4, 7}, e.ToSlice())
+	checkEqual(t, 10, calls)
+
+	e2 := ThenBy(OrderBy(Range(0, 10), func(i int) int {
+		calls++
+		return i % 2
+	}), func(i int) int {
+		calls++
+		return -i
+	})
+	calls = 0
+	checkEqual(t, []int{8, 6, 4, 2, 0, 9, 7, 5, 3, 1}, e2.ToSlice())
+	checkEqual(t, 20, calls)
+}
+
 func Test_Enumerator_Where(t *testing.T) {
 	e1 := Enumerate(`cat`, `bat`, `wolf`, `hat`, `mouse`, `dog`).
 		Where(func(value string) bool { return len(value) == 3 })
@@ -163,7 +163,7 @@ func (e enumeratorImp[T]) Append(tails ...T) collections.Enumerator[T] {
 }
 
 func (e enumeratorImp[T]) Concat(tails ...collections.Enumerator[T]) collections.Enumerator[T] {
-	return New(func() collections.Iterator[T] {
+	result := New(func() collections.Iterator[T] {
 		its := make([]collections.Iterator[T], len(tails)+1)
 		its[0] = e.Iterate()
 		for i, t := range tails {
@@ -171,6 +171,12 @@ func (e enumeratorImp[T]) Concat(tails ...collections.Enumerator[T]) collections
 		}
 		return iterator.Concat(its)
 	})
+	for _, t := range tails {
+		if IsInfinite(t) {
+			return markInfinite(result)
+		}
+	}
+	return result
 }
 
 func (e enumeratorImp[T]) SortInterweave(other collections.Enumerator[T], comparer ...comp.Comparer[T]) collections.Enumerator[T] {
@@ -243,6 +249,7 @@ func (e enumeratorImp[T]) Buffered() collections.Enumerator[T] {
 					return value, true
 				}
 
+				_ = iterator.Close(source)
 				source = nil
 				loading = false
 			}
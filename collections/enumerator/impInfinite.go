@@ -0,0 +1,149 @@
+package enumerator
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// infiniteImp is an enumerator which is known to never run out of values.
+//
+// The operators which would never return when reading an infinite
+// enumerator panic instead, and the operators which keep the enumerator
+// infinite return another infinite enumerator.
+type infiniteImp[T any] struct {
+	enumeratorImp[T]
+}
+
+// infinite marks the enumerator as known to be infinite.
+func (e infiniteImp[T]) infinite() {}
+
+// newInfinite creates a new enumerator which is known to be infinite.
+func newInfinite[T any](iterable collections.Iterable[T]) collections.Enumerator[T] {
+	return infiniteImp[T]{
+		enumeratorImp: enumeratorImp[T]{
+			iterable: iterable,
+		},
+	}
+}
+
+// markInfinite wraps the given enumerator as known to be infinite.
+func markInfinite[T any](e collections.Enumerator[T]) collections.Enumerator[T] {
+	return newInfinite(e.Iterate)
+}
+
+// keepInfinite marks the given result as known to be infinite
+// if the given source enumerator is known to be infinite.
+func keepInfinite[TIn, TOut any](e collections.Enumerator[TIn], result collections.Enumerator[TOut]) collections.Enumerator[TOut] {
+	if IsInfinite(e) {
+		return markInfinite(result)
+	}
+	return result
+}
+
+// infiniteErr creates the error for when an action can not be
+// performed because the enumerator is infinite.
+func infiniteErr(action string) terrors.TError {
+	return terror.New(`may not perform action on an infinite enumerator`).
+		With(`action`, action)
+}
+
+// checkFinite panics if the given enumerator is known to be infinite.
+func checkFinite[T any](e collections.Enumerator[T], action string) {
+	if IsInfinite(e) {
+		panic(infiniteErr(action))
+	}
+}
+
+func (e infiniteImp[T]) Where(p collections.Predicate[T]) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Where(p))
+}
+
+func (e infiniteImp[T]) WhereNot(p collections.Predicate[T]) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.WhereNot(p))
+}
+
+func (e infiniteImp[T]) NotNil() collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.NotNil())
+}
+
+func (e infiniteImp[T]) NotZero() collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.NotZero())
+}
+
+func (e infiniteImp[T]) ToSlice() []T {
+	panic(infiniteErr(`ToSlice`))
+}
+
+func (e infiniteImp[T]) Foreach(_ func(value T)) {
+	panic(infiniteErr(`Foreach`))
+}
+
+func (e infiniteImp[T]) Count() int {
+	panic(infiniteErr(`Count`))
+}
+
+func (e infiniteImp[T]) Last() (T, bool) {
+	panic(infiniteErr(`Last`))
+}
+
+func (e infiniteImp[T]) Skip(count int) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Skip(count))
+}
+
+func (e infiniteImp[T]) SkipWhile(p collections.Predicate[T]) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.SkipWhile(p))
+}
+
+func (e infiniteImp[T]) Replace(replacer collections.Selector[T, T]) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Replace(replacer))
+}
+
+func (e infiniteImp[T]) Reverse() collections.Enumerator[T] {
+	panic(infiniteErr(`Reverse`))
+}
+
+func (e infiniteImp[T]) Strings() collections.Enumerator[string] {
+	return markInfinite(e.enumeratorImp.Strings())
+}
+
+func (e infiniteImp[T]) Quotes() collections.Enumerator[string] {
+	return markInfinite(e.enumeratorImp.Quotes())
+}
+
+func (e infiniteImp[T]) Trim() collections.Enumerator[string] {
+	return markInfinite(e.enumeratorImp.Trim())
+}
+
+func (e infiniteImp[T]) Join(_ string) string {
+	panic(infiniteErr(`Join`))
+}
+
+func (e infiniteImp[T]) Append(tails ...T) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Append(tails...))
+}
+
+func (e infiniteImp[T]) Concat(tails ...collections.Enumerator[T]) collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Concat(tails...))
+}
+
+func (e infiniteImp[T]) Sort(_ ...comp.Comparer[T]) collections.Enumerator[T] {
+	panic(infiniteErr(`Sort`))
+}
+
+func (e infiniteImp[T]) Merge(_ collections.Reducer[T, T]) T {
+	panic(infiniteErr(`Merge`))
+}
+
+func (e infiniteImp[T]) Max(_ ...comp.Comparer[T]) T {
+	panic(infiniteErr(`Max`))
+}
+
+func (e infiniteImp[T]) Min(_ ...comp.Comparer[T]) T {
+	panic(infiniteErr(`Min`))
+}
+
+func (e infiniteImp[T]) Buffered() collections.Enumerator[T] {
+	return markInfinite(e.enumeratorImp.Buffered())
+}
@@ -0,0 +1,111 @@
+package enumerator
+
+import (
+	"slices"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// orderLevel prepares one level of ordering for the given values.
+// It returns a comparer for the indices into the given values.
+type orderLevel[T any] func(values []T) comp.Comparer[int]
+
+// keyLevel creates an ordering level which selects the keys
+// for each value once prior to sorting the values.
+func keyLevel[T, K any](keySelector collections.Selector[T, K], cmp comp.Comparer[K], descending bool) orderLevel[T] {
+	if descending {
+		cmp = comp.Descender(cmp)
+	}
+	return func(values []T) comp.Comparer[int] {
+		keys := make([]K, len(values))
+		for i, value := range values {
+			keys[i] = keySelector(value)
+		}
+		return func(x, y int) int {
+			return cmp(keys[x], keys[y])
+		}
+	}
+}
+
+// valueLevel creates an ordering level which compares the values directly.
+func valueLevel[T any](cmp comp.Comparer[T], descending bool) orderLevel[T] {
+	if descending {
+		cmp = comp.Descender(cmp)
+	}
+	return func(values []T) comp.Comparer[int] {
+		return func(x, y int) int {
+			return cmp(values[x], values[y])
+		}
+	}
+}
+
+type orderedImp[T any] struct {
+	collections.Enumerator[T]
+	source collections.Enumerator[T]
+	levels []orderLevel[T]
+}
+
+func newOrdered[T any](source collections.Enumerator[T], levels []orderLevel[T]) collections.OrderedEnumerator[T] {
+	o := &orderedImp[T]{
+		Enumerator: nil,
+		source:     source,
+		levels:     levels,
+	}
+	o.Enumerator = New(o.iterate)
+	return o
+}
+
+func (o *orderedImp[T]) thenBy(level orderLevel[T]) collections.OrderedEnumerator[T] {
+	levels := append(slices.Clip(o.levels), level)
+	return newOrdered(o.source, levels)
+}
+
+func (o *orderedImp[T]) iterate() collections.Iterator[T] {
+	first := true
+	var values []T
+	var order []int
+	index, count := -1, 0
+	return iterator.New(func() (T, bool) {
+		if first {
+			first = false
+			values = iterator.ToSlice(o.source.Iterate())
+			count = len(values)
+			order = make([]int, count)
+			for i := range order {
+				order[i] = i
+			}
+
+			cmps := make([]comp.Comparer[int], len(o.levels))
+			for i, level := range o.levels {
+				cmps[i] = level(values)
+			}
+			slices.SortStableFunc(order, func(x, y int) int {
+				for _, cmp := range cmps {
+					if c := cmp(x, y); c != 0 {
+						return c
+					}
+				}
+				return 0
+			})
+		}
+
+		if index+1 < count {
+			index++
+			return values[order[index]], true
+		}
+		values, order = nil, nil
+		return utils.Zero[T](), false
+	})
+}
+
+func (o *orderedImp[T]) ThenBy(comparer ...comp.Comparer[T]) collections.OrderedEnumerator[T] {
+	return o.thenBy(valueLevel(optional.Comparer(comparer), false))
+}
+
+func (o *orderedImp[T]) ThenByDescending(comparer ...comp.Comparer[T]) collections.OrderedEnumerator[T] {
+	return o.thenBy(valueLevel(optional.Comparer(comparer), true))
+}
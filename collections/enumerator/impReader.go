@@ -0,0 +1,28 @@
+package enumerator
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/internal/readers"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// readerIterable creates an iterable for values read from the given source.
+// If reading fails, a terror with the index of the value being read is panicked.
+func readerIterable[T any](source readers.Source[T]) collections.Iterable[T] {
+	return func() collections.Iterator[T] {
+		fetch := source()
+		index := 0
+		return iterator.New(func() (T, bool) {
+			value, ok, err := fetch()
+			if err != nil {
+				panic(terror.New(`failed to read from reader`, err).
+					With(`index`, index))
+			}
+			if ok {
+				index++
+			}
+			return value, ok
+		})
+	}
+}
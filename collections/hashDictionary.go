@@ -0,0 +1,59 @@
+package collections
+
+// HashDictionary is the interface for key/value pairs
+// whose keys are only required to be hashable, not `comparable`.
+//
+// This has all the methods of `Dictionary` except `AddMap` and
+// `AddMapIfNotSet`, since a Go map requires `comparable` keys.
+// The keys are unique as determined by the dictionary's hasher.
+type HashDictionary[TKey, TValue any] interface {
+	ReadonlyHashDictionary[TKey, TValue]
+
+	// Add will add or overwrite the key with the given value.
+	// Returns true if the key was added or, if the key
+	// existed but the value is different, otherwise returns false.
+	Add(key TKey, value TValue) bool
+
+	// AddIfNotSet will add the given key with the given value if the
+	// given key doesn't exist. If the key exists the value is not overwritten.
+	// Returns true if the key was added or false if not added.
+	AddIfNotSet(key TKey, value TValue) bool
+
+	// AddFrom adds all the key/value pairs from the tuples.
+	// This will overwrite any existing value with the same key.
+	// Returns true if any key/value was added or overwritten,
+	// false if none were changed.
+	AddFrom(e Enumerator[Tuple2[TKey, TValue]]) bool
+
+	// AddIfNotSetFrom adds all the key/value pairs
+	// from the tuples for each key that doesn't exist.
+	// If the key exists the value is not overwritten.
+	// Returns true if any key was added, false if none were added.
+	AddIfNotSetFrom(e Enumerator[Tuple2[TKey, TValue]]) bool
+
+	// Remove removes the given keys.
+	// Returns true if any key existed and was removed, false if none of the keys existed.
+	Remove(keys ...TKey) bool
+
+	// RemoveIf removes the keys that the predicate returns true for.
+	// Returns true if any key was removed, false if nothing was removed.
+	RemoveIf(p Predicate[TKey]) bool
+
+	// Refresh will rehash the keys, remove duplicate keys,
+	// and randomly pick the value from duplicate keys.
+	// This only needs to be called if the keys in the dictionary are
+	// modified in a way that the hash or equality of keys may have changed.
+	Refresh()
+
+	// Clear removes all the entries from the dictionary.
+	Clear()
+
+	// Clones this dictionary.
+	Clone() HashDictionary[TKey, TValue]
+
+	// Readonly gets a readonly version of this dictionary.
+	//
+	// The readonly version points back to this dictionary
+	// but is not able to be cast into this dictionary.
+	Readonly() ReadonlyHashDictionary[TKey, TValue]
+}
@@ -0,0 +1,88 @@
+package hashDictionary
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/dictionary"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+)
+
+// benchmarkDictionary is the subset of methods shared by
+// `Dictionary` and `HashDictionary` which are benchmarked.
+type benchmarkDictionary interface {
+	Add(key int, value int) bool
+	TryGet(key int) (int, bool)
+	Remove(keys ...int) bool
+}
+
+// intHasher is a hasher for integers which doesn't use reflection,
+// to show the cost of the table separately from the cost of `comp.Hash`.
+func intHasher() comp.Hasher[int] {
+	return comp.NewHasher(func(value int) uint64 {
+		return uint64(value)
+	}, func(x, y int) bool { return x == y })
+}
+
+var benchmarkDictionaries = []struct {
+	name   string
+	create func(capacity int) benchmarkDictionary
+}{
+	{name: `Dictionary`, create: func(capacity int) benchmarkDictionary {
+		return dictionary.New[int, int](capacity)
+	}},
+	{name: `HashDictionary`, create: func(capacity int) benchmarkDictionary {
+		return CapNew[int, int](capacity, intHasher())
+	}},
+	{name: `HashDictionary_Default`, create: func(capacity int) benchmarkDictionary {
+		return CapNew[int, int](capacity)
+	}},
+}
+
+func Benchmark_Dictionary_Add(b *testing.B) {
+	for _, bd := range benchmarkDictionaries {
+		for _, size := range []int{100, 10000} {
+			b.Run(fmt.Sprintf(`%s_%d`, bd.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					d := bd.create(0)
+					for j := 0; j < size; j++ {
+						d.Add(j, j)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_Dictionary_TryGet(b *testing.B) {
+	for _, bd := range benchmarkDictionaries {
+		for _, size := range []int{100, 10000} {
+			b.Run(fmt.Sprintf(`%s_%d`, bd.name, size), func(b *testing.B) {
+				d := bd.create(size)
+				for j := 0; j < size; j++ {
+					d.Add(j*2, j)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					d.TryGet(i % (size * 2))
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_Dictionary_AddRemove(b *testing.B) {
+	for _, bd := range benchmarkDictionaries {
+		b.Run(bd.name, func(b *testing.B) {
+			d := bd.create(1000)
+			for j := 0; j < 1000; j++ {
+				d.Add(j, j)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				d.Remove(i % 1000)
+				d.Add(i%1000, i)
+			}
+		})
+	}
+}
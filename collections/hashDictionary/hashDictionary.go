@@ -0,0 +1,57 @@
+package hashDictionary
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/openTable"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+)
+
+// New creates a new dictionary for keys which don't have to be `comparable`.
+//
+// The keys are hashed and checked for equality with the optional
+// given hasher or the default hasher, see `comp.DefaultHasher`.
+// The entries are stored in an open-addressing hash table
+// and are enumerated in an unspecified order.
+//
+// A dictionary using a custom hasher is only equal to another dictionary
+// using a custom hasher or comparer when each has the other's entries
+// and both have the same hash.
+func New[TKey, TValue any](hasher ...comp.Hasher[TKey]) collections.HashDictionary[TKey, TValue] {
+	return CapNew[TKey, TValue](0, hasher...)
+}
+
+// CapNew creates a new dictionary with enough initial capacity to hold the
+// given number of entries, using the optional given hasher or the default hasher.
+func CapNew[TKey, TValue any](capacity int, hasher ...comp.Hasher[TKey]) collections.HashDictionary[TKey, TValue] {
+	return &hashDictionaryImp[TKey, TValue]{
+		t:      openTable.New[TKey, TValue](optional.Hasher(hasher), capacity),
+		event:  nil,
+		custom: optional.Given(hasher),
+	}
+}
+
+// With creates a new dictionary populated with key/value pairs from the
+// given map, using the optional given hasher or the default hasher.
+func With[TKey comparable, TValue any, M ~map[TKey]TValue](m M, hasher ...comp.Hasher[TKey]) collections.HashDictionary[TKey, TValue] {
+	d := CapNew[TKey, TValue](len(m), hasher...)
+	for key, value := range m {
+		d.Add(key, value)
+	}
+	return d
+}
+
+// From creates a new dictionary populated with key/value pairs from the given
+// tuple enumerator, using the optional given hasher or the default hasher.
+func From[TKey, TValue any](e collections.Enumerator[collections.Tuple2[TKey, TValue]], hasher ...comp.Hasher[TKey]) collections.HashDictionary[TKey, TValue] {
+	return CapFrom(e, 0, hasher...)
+}
+
+// CapFrom creates a new dictionary with an initial capacity
+// populated with key/value pairs from the given tuple enumerator,
+// using the optional given hasher or the default hasher.
+func CapFrom[TKey, TValue any](e collections.Enumerator[collections.Tuple2[TKey, TValue]], capacity int, hasher ...comp.Hasher[TKey]) collections.HashDictionary[TKey, TValue] {
+	d := CapNew[TKey, TValue](capacity, hasher...)
+	d.AddFrom(e)
+	return d
+}
@@ -0,0 +1,176 @@
+package hashDictionary
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/dictionary"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/events/listener"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+func Test_HashDictionary(t *testing.T) {
+	d1 := New[int, int]()
+	check.Empty(t).Assert(d1)
+	check.True(t).Assert(d1.Empty())
+
+	check.True(t).Assert(d1.Add(123, 321))
+	check.True(t).Assert(d1.Add(123, 456))
+	check.False(t).Assert(d1.Add(123, 456))
+	check.Length(t, 1).Assert(d1)
+	check.Equal(t, 456).Assert(d1.Get(123))
+	check.True(t).Assert(d1.Contains(123))
+	check.False(t).Assert(d1.Contains(765))
+
+	check.False(t).Assert(d1.AddIfNotSet(123, 555))
+	check.True(t).Assert(d1.AddIfNotSet(222, 333))
+	check.Length(t, 2).Assert(d1)
+	check.Equal(t, 333).Assert(d1.Get(222))
+
+	v, ok := d1.TryGet(251)
+	check.Zero(t).Assert(v)
+	check.False(t).Assert(ok)
+
+	check.String(t, "123: 456\n222: 333").Assert(d1)
+	d2 := d1.Clone()
+	check.String(t, "123: 456\n222: 333").Assert(d2)
+	check.Equal(t, d1).Name(`d1.Equals(d2)`).Assert(d2)
+
+	check.False(t).Assert(d1.Remove(833))
+	check.True(t).Assert(d1.Remove(222))
+	check.NotEqual(t, d1).Name(`d1.Equals(d2)`).Assert(d2)
+	d1.Clear()
+	check.Empty(t).Assert(d1)
+
+	check.Equal(t, `[123, 456]|[222, 333]`).Assert(d2.Enumerate().Strings().Sort().Join(`|`))
+	check.Equal(t, `123|222`).Assert(d2.Keys().Sort().Join(`|`))
+	check.Equal(t, `333|456`).Assert(d2.Values().Sort().Join(`|`))
+	check.String(t, "123: 456\n222: 333").Assert(d2.Readonly())
+
+	check.False(t).Assert(d2.RemoveIf(predicate.LessThan(10)))
+	check.False(t).Assert(d2.RemoveIf(nil))
+	check.True(t).Assert(d2.RemoveIf(predicate.GreaterThan(200)))
+	check.String(t, "123: 456").Assert(d2)
+
+	d3 := With(map[string]string{`One`: `I`, `Two`: `II`})
+	check.String(t, "One: I\nTwo: II").Assert(d3)
+	d4 := From[string, string](nil)
+	check.True(t).Assert(d4.AddIfNotSetFrom(enumerator.Enumerate(tuple2.New(`One`, `1`), tuple2.New(`Three`, `3`))))
+	check.True(t).Assert(d4.AddFrom(d3.Enumerate()))
+	check.False(t).Assert(d4.AddIfNotSetFrom(d3.Enumerate()))
+	check.String(t, "One:   I\nThree: 3\nTwo:   II").Assert(d4)
+}
+
+func Test_HashDictionary_NonComparable(t *testing.T) {
+	d := New[[]string, int]()
+	check.True(t).Assert(d.Add([]string{`a`, `b`}, 1))
+	check.True(t).Assert(d.Add([]string{`b`, `a`}, 2))
+	check.True(t).Assert(d.Add([]string{`a`, `b`}, 3))
+	check.Length(t, 2).Assert(d)
+	check.Equal(t, 3).Assert(d.Get([]string{`a`, `b`}))
+	check.String(t, "[a b]: 3\n[b a]: 2").Assert(d)
+
+	// Modify a key so that it equals another key.
+	key := []string{`c`, `d`}
+	d.Add(key, 4)
+	d.Refresh() // No effect
+	check.Length(t, 3).Assert(d)
+	key[0], key[1] = `a`, `b`
+	check.Length(t, 3).Assert(d)
+	d.Refresh()
+	check.Length(t, 2).Assert(d)
+}
+
+func Test_HashDictionary_CustomHasher(t *testing.T) {
+	folded := comp.NewHasher(func(value string) uint64 {
+		return comp.Hash(strings.ToLower(value))
+	}, strings.EqualFold)
+	d := New[string, int](folded)
+	check.True(t).Assert(d.Add(`Cat`, 1))
+	check.True(t).Assert(d.Add(`CAT`, 2))
+	check.Length(t, 1).Assert(d)
+	check.Equal(t, 2).Assert(d.Get(`cat`))
+	check.String(t, `Cat: 2`).Assert(d)
+
+	check.MatchError(t, `^invalid number of arguments \{count: 2, maximum: 1, usage: hasher\}$`).
+		Panic(func() { New[string, int](folded, folded) })
+}
+
+func Test_HashDictionary_OnChange(t *testing.T) {
+	buf := &bytes.Buffer{}
+	d := New[int, int]()
+	lis := listener.New(func(args collections.ChangeArgs) {
+		_, _ = buf.WriteString(args.Type().String())
+	})
+	defer lis.Cancel()
+	check.True(t).Assert(lis.Subscribe(d.OnChange()))
+
+	check.True(t).Assert(d.Add(1, 2))
+	check.StringAndReset(t, `Added`).Assert(buf)
+	check.False(t).Assert(d.Add(1, 2))
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(d.Add(1, 3))
+	check.StringAndReset(t, `Replaced`).Assert(buf)
+	check.False(t).Assert(d.AddIfNotSet(1, 4))
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(d.AddIfNotSet(4, 5))
+	check.StringAndReset(t, `Added`).Assert(buf)
+
+	check.True(t).Assert(d.Remove(4, 7))
+	check.StringAndReset(t, `Removed`).Assert(buf)
+	check.False(t).Assert(d.Remove(4, 7))
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(d.RemoveIf(predicate.LessThan(4)))
+	check.StringAndReset(t, `Removed`).Assert(buf)
+
+	d.Clear()
+	check.StringAndReset(t, ``).Assert(buf)
+	d.Add(1, 1)
+	check.StringAndReset(t, `Added`).Assert(buf)
+	d.Clear()
+	check.StringAndReset(t, `Removed`).Assert(buf)
+}
+
+func Test_HashDictionary_EqualsAcrossImplementations(t *testing.T) {
+	d1 := With(map[string]int{`one`: 1, `two`: 2, `three`: 3})
+	d2 := dictionary.With(map[string]int{`three`: 3, `two`: 2, `one`: 1})
+	check.True(t).Assert(d1.Equals(d2))
+	check.True(t).Assert(d2.Equals(d1))
+	check.True(t).Assert(d1.Readonly().Equals(d2.Readonly()))
+	check.True(t).Assert(d2.Readonly().Equals(d1.Readonly()))
+	check.Equal(t, d1.Hash()).Assert(d2.Hash())
+	check.Equal(t, d1.Hash()).Assert(d1.Readonly().Hash())
+
+	d2.Add(`two`, 22)
+	check.False(t).Assert(d1.Equals(d2))
+	check.False(t).Assert(d2.Equals(d1))
+
+	// A dictionary is not equal to other collections of tuples.
+	check.False(t).Assert(d1.Equals(d1.Enumerate()))
+}
+
+func Test_HashDictionary_EqualsWithCustomHasher(t *testing.T) {
+	folded := comp.NewHasher(func(value string) uint64 {
+		return comp.Hash(strings.ToLower(value))
+	}, strings.EqualFold)
+	d1 := With(map[string]int{`One`: 1, `two`: 2}, folded)
+	d2 := dictionary.With(map[string]int{`One`: 1, `two`: 2})
+	check.False(t).Assert(d1.Equals(d2))
+	check.False(t).Assert(d2.Equals(d1))
+	check.False(t).Assert(d2.Readonly().Equals(d1.Readonly()))
+
+	d3 := With(map[string]int{`One`: 1, `two`: 2})
+	check.True(t).Assert(d3.Equals(d2))
+	check.Equal(t, d2.Hash()).Assert(d3.Hash())
+
+	d4 := With(map[string]int{`one`: 1, `TWO`: 2}, folded)
+	check.True(t).Assert(d1.Equals(d4))
+	check.True(t).Assert(d4.Readonly().Equals(d1.Readonly()))
+	check.Equal(t, d1.Hash()).Assert(d4.Hash())
+}
@@ -0,0 +1,251 @@
+package hashDictionary
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/changeArgs"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/collections/readonlyHashDictionary"
+	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
+	"github.com/Snow-Gremlin/goToolbox/internal/openTable"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type changeFlag int
+
+const (
+	noChange      changeFlag = 0
+	addChange     changeFlag = 1
+	removeChange  changeFlag = 2
+	replaceChange changeFlag = addChange | removeChange
+)
+
+type hashDictionaryImp[TKey, TValue any] struct {
+	t      *openTable.Table[TKey, TValue]
+	event  events.Event[collections.ChangeArgs]
+	custom bool
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) onChanged(cf changeFlag) bool {
+	if d.event != nil {
+		switch cf {
+		case addChange:
+			d.event.Invoke(changeArgs.NewAdded())
+		case removeChange:
+			d.event.Invoke(changeArgs.NewRemoved())
+		case replaceChange:
+			d.event.Invoke(changeArgs.NewReplaced())
+		}
+	}
+	return cf != noChange
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) addOne(key TKey, val TValue) changeFlag {
+	if v2, exists := d.t.Get(key); exists {
+		if comp.Equal(val, v2) {
+			return noChange
+		}
+		d.t.Set(key, val)
+		return replaceChange
+	}
+	d.t.Set(key, val)
+	return addChange
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) addOneIfNotSet(key TKey, val TValue) changeFlag {
+	if d.t.Add(key, val) {
+		return addChange
+	}
+	return noChange
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Add(key TKey, val TValue) bool {
+	return d.onChanged(d.addOne(key, val))
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) AddIfNotSet(key TKey, val TValue) bool {
+	return d.onChanged(d.addOneIfNotSet(key, val))
+}
+
+func addFromTo[TKey, TValue any](e collections.Enumerator[collections.Tuple2[TKey, TValue]], addHandle func(key TKey, val TValue) changeFlag) changeFlag {
+	if utils.IsNil(e) {
+		return noChange
+	}
+	result := noChange
+	e.All(func(t collections.Tuple2[TKey, TValue]) bool {
+		result |= addHandle(t.Values())
+		return true
+	})
+	return result
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) AddFrom(e collections.Enumerator[collections.Tuple2[TKey, TValue]]) bool {
+	return d.onChanged(addFromTo(e, d.addOne))
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) AddIfNotSetFrom(e collections.Enumerator[collections.Tuple2[TKey, TValue]]) bool {
+	return d.onChanged(addFromTo(e, d.addOneIfNotSet))
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Get(key TKey) TValue {
+	value, _ := d.t.Get(key)
+	return value
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) TryGet(key TKey) (TValue, bool) {
+	return d.t.Get(key)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Remove(keys ...TKey) bool {
+	result := noChange
+	for _, key := range keys {
+		if d.t.Remove(key) {
+			result = removeChange
+		}
+	}
+	return d.onChanged(result)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) RemoveIf(p collections.Predicate[TKey]) bool {
+	if utils.IsNil(p) {
+		return false
+	}
+	if d.t.RemoveIf(func(key TKey, _ TValue) bool { return p(key) }) {
+		return d.onChanged(removeChange)
+	}
+	return false
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Refresh() {
+	if d.t.Rehash() {
+		d.onChanged(removeChange)
+	}
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Clear() {
+	if d.t.Count() > 0 {
+		d.t.Clear()
+		d.onChanged(removeChange)
+	}
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Clone() collections.HashDictionary[TKey, TValue] {
+	return &hashDictionaryImp[TKey, TValue]{
+		t:      d.t.Clone(),
+		event:  nil,
+		custom: d.custom,
+	}
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Readonly() collections.ReadonlyHashDictionary[TKey, TValue] {
+	return readonlyHashDictionary.New(d)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) OnChange() events.Event[collections.ChangeArgs] {
+	if d.event == nil {
+		d.event = event.New[collections.ChangeArgs]()
+	}
+	return d.event
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Enumerate() collections.Enumerator[collections.Tuple2[TKey, TValue]] {
+	// The keys are collected once before iteration so that changes
+	// to the dictionary, which may move entries around in the table, may just
+	// cause the enumeration to be unstable but doesn't require it to be stopped.
+	return enumerator.New(func() collections.Iterator[collections.Tuple2[TKey, TValue]] {
+		keys := d.t.Keys()
+		index, count := -1, len(keys)-1
+		return iterator.New(func() (collections.Tuple2[TKey, TValue], bool) {
+			for index < count {
+				index++
+				key := keys[index]
+				if value, ok := d.t.Get(key); ok {
+					return tuple2.New(key, value), true
+				}
+			}
+			return utils.Zero[collections.Tuple2[TKey, TValue]](), false
+		})
+	})
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Keys() collections.Enumerator[TKey] {
+	// See comment in Enumerate
+	return enumerator.New(func() collections.Iterator[TKey] {
+		keys := d.t.Keys()
+		index, count := -1, len(keys)-1
+		return iterator.New(func() (TKey, bool) {
+			if index < count {
+				index++
+				return keys[index], true
+			}
+			return utils.Zero[TKey](), false
+		})
+	})
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Values() collections.Enumerator[TValue] {
+	// See comment in Enumerate
+	return enumerator.New(func() collections.Iterator[TValue] {
+		values := d.t.Values()
+		index, count := -1, len(values)-1
+		return iterator.New(func() (TValue, bool) {
+			if index < count {
+				index++
+				return values[index], true
+			}
+			return utils.Zero[TValue](), false
+		})
+	})
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Empty() bool {
+	return d.t.Count() <= 0
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Count() int {
+	return d.t.Count()
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Contains(key TKey) bool {
+	return d.t.Has(key)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) String() string {
+	const newline = "\n"
+	keys, values := d.t.Keys(), d.t.Values()
+	keyStr := utils.Strings(keys)
+	maxWidth := utils.GetMaxStringLen(keyStr) + 2
+	padding := newline + strings.Repeat(` `, maxWidth)
+	lines := make([]string, len(keys))
+	for i := range keys {
+		value := utils.String(values[i])
+		value = strings.ReplaceAll(value, newline, padding)
+		lines[i] = fmt.Sprintf(`%-*s%s`, maxWidth, keyStr[i]+`: `, value)
+	}
+	slices.Sort(lines)
+	return strings.Join(lines, newline)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) CustomEquality() bool {
+	return d.custom
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Equals(other any) bool {
+	d2, ok := other.(collections.ReadonlyHashDictionary[TKey, TValue])
+	return ok && equality.Dictionary[TKey, TValue](d, d2)
+}
+
+func (d *hashDictionaryImp[TKey, TValue]) Hash() uint64 {
+	if !d.custom {
+		return equality.DictionaryHash[TKey, TValue](d)
+	}
+	return equality.DictionaryHashBy[TKey, TValue](d, d.t.Hasher().Hash)
+}
@@ -0,0 +1,81 @@
+package hashSet
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/set"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+)
+
+// intHasher is a hasher for integers which doesn't use reflection,
+// to show the cost of the table separately from the cost of `comp.Hash`.
+func intHasher() comp.Hasher[int] {
+	return comp.NewHasher(func(value int) uint64 {
+		return uint64(value)
+	}, func(x, y int) bool { return x == y })
+}
+
+var benchmarkSets = []struct {
+	name   string
+	create func(capacity int) collections.Set[int]
+}{
+	{name: `Set`, create: func(capacity int) collections.Set[int] {
+		return set.New[int](capacity)
+	}},
+	{name: `HashSet`, create: func(capacity int) collections.Set[int] {
+		return CapNew(capacity, intHasher())
+	}},
+	{name: `HashSet_Default`, create: func(capacity int) collections.Set[int] {
+		return CapNew[int](capacity)
+	}},
+}
+
+func Benchmark_Set_Add(b *testing.B) {
+	for _, bs := range benchmarkSets {
+		for _, size := range []int{100, 10000} {
+			b.Run(fmt.Sprintf(`%s_%d`, bs.name, size), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					s := bs.create(0)
+					for j := 0; j < size; j++ {
+						s.Add(j)
+					}
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_Set_Contains(b *testing.B) {
+	for _, bs := range benchmarkSets {
+		for _, size := range []int{100, 10000} {
+			b.Run(fmt.Sprintf(`%s_%d`, bs.name, size), func(b *testing.B) {
+				s := bs.create(size)
+				for j := 0; j < size; j++ {
+					s.Add(j * 2)
+				}
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					s.Contains(i % (size * 2))
+				}
+			})
+		}
+	}
+}
+
+func Benchmark_Set_AddRemove(b *testing.B) {
+	for _, bs := range benchmarkSets {
+		b.Run(bs.name, func(b *testing.B) {
+			s := bs.create(1000)
+			for j := 0; j < 1000; j++ {
+				s.Add(j)
+			}
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				s.Remove(i % 1000)
+				s.Add(i % 1000)
+			}
+		})
+	}
+}
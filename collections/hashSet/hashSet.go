@@ -0,0 +1,55 @@
+package hashSet
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/openTable"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+)
+
+// New creates a new set for values which don't have to be `comparable`.
+//
+// The values are hashed and checked for equality with the optional
+// given hasher or the default hasher, see `comp.DefaultHasher`.
+// The values are stored in an open-addressing hash table
+// and are enumerated in an unspecified order.
+//
+// A set using the default hasher may be equal to other kinds of sets and has
+// the same hash as them. A set using a custom hasher is only equal to another
+// set using a custom hasher or comparer when each set contains the other's
+// values and both sets have the same hash.
+func New[T any](hasher ...comp.Hasher[T]) collections.Set[T] {
+	return CapNew(0, hasher...)
+}
+
+// CapNew creates a new set with enough initial capacity to hold the given
+// number of values, using the optional given hasher or the default hasher.
+func CapNew[T any](capacity int, hasher ...comp.Hasher[T]) collections.Set[T] {
+	return &hashSetImp[T]{
+		t:      openTable.New[T, struct{}](optional.Hasher(hasher), capacity),
+		event:  nil,
+		custom: optional.Given(hasher),
+	}
+}
+
+// With creates a new set with the given values,
+// using the optional given hasher or the default hasher.
+func With[T any](s []T, hasher ...comp.Hasher[T]) collections.Set[T] {
+	return CapFrom(enumerator.Enumerate(s...), len(s), hasher...)
+}
+
+// From creates a new set from the given enumerator,
+// using the optional given hasher or the default hasher.
+func From[T any](e collections.Enumerator[T], hasher ...comp.Hasher[T]) collections.Set[T] {
+	return CapFrom(e, 0, hasher...)
+}
+
+// CapFrom creates a new set with an initial capacity
+// populated with values from the given enumerator,
+// using the optional given hasher or the default hasher.
+func CapFrom[T any](e collections.Enumerator[T], capacity int, hasher ...comp.Hasher[T]) collections.Set[T] {
+	s := CapNew(capacity, hasher...)
+	s.AddFrom(e)
+	return s
+}
@@ -0,0 +1,206 @@
+package hashSet
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/list"
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/set"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/events/listener"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+func foldedHasher() comp.Hasher[string] {
+	return comp.NewHasher(func(value string) uint64 {
+		return comp.Hash(strings.ToLower(value))
+	}, strings.EqualFold)
+}
+
+func Test_HashSet(t *testing.T) {
+	s := With([]int{1, 2, 3})
+	check.Length(t, 3).Assert(s)
+	check.String(t, `1, 2, 3`).Assert(s)
+	check.False(t).Assert(s.Empty())
+
+	p := s.ToSlice()
+	slices.Sort(p)
+	check.Equal(t, []int{1, 2, 3}).Assert(p)
+	check.Length(t, 3).Assert(s.ToList())
+
+	p = make([]int, 1)
+	s.CopyToSlice(p) // Didn't panic
+
+	p = make([]int, 5)
+	s.CopyToSlice(p)
+	slices.Sort(p)
+	check.Equal(t, []int{0, 0, 1, 2, 3}).Assert(p)
+
+	check.True(t).Assert(s.Contains(1))
+	check.False(t).Assert(s.Contains(4))
+
+	check.False(t).Assert(s.Add(1, 2))
+	check.True(t).Assert(s.Add(3, 5))
+	check.String(t, `1, 2, 3, 5`).Assert(s)
+	check.String(t, `1, 2, 3, 5`).Assert(s.Readonly())
+
+	s2 := s.Clone()
+	check.Equal(t, s2).Assert(s)
+	check.True(t).Assert(s2.Add(4))
+	check.True(t).Assert(s2.Remove(5))
+	check.String(t, `1, 2, 3, 4`).Assert(s2)
+	check.NotEqual(t, s2).Assert(s)
+
+	s2.Clear()
+	check.Empty(t).Assert(s2)
+	check.String(t, ``).Assert(s2)
+
+	check.True(t).Assert(s.Remove(4, 5))
+	check.False(t).Assert(s.Remove(4, 5))
+	check.String(t, `1, 2, 3`).Assert(s)
+
+	check.True(t).Assert(s.Add(4, 5, 6, 7, 8))
+	check.False(t).Assert(s.RemoveIf(predicate.IsZero[int]()))
+	check.False(t).Assert(s.RemoveIf(nil))
+	check.True(t).Assert(s.RemoveIf(predicate.LessThan(5)))
+	check.String(t, `5, 6, 7, 8`).Assert(s)
+
+	check.False(t).Assert(s.AddFrom(nil))
+	check.False(t).Assert(s.AddFrom(enumerator.Range(5, 3)))
+	check.True(t).Assert(s.AddFrom(enumerator.Range(9, 3)))
+	check.String(t, `10, 11, 5, 6, 7, 8, 9`).Assert(s)
+}
+
+func Test_HashSet_NonComparable(t *testing.T) {
+	s := New[[]int]()
+	check.True(t).Assert(s.Add([]int{1, 2}, []int{2, 1}))
+	check.False(t).Assert(s.Add([]int{1, 2}))
+	check.True(t).Assert(s.Contains([]int{2, 1}))
+	check.False(t).Assert(s.Contains([]int{1}))
+	check.String(t, `[1 2], [2 1]`).Assert(s)
+
+	// Modify a value so that it equals another value.
+	s2 := New[[]int]()
+	a, b := []int{1}, []int{2}
+	s2.Add(a, b)
+	s2.Refresh() // No effect
+	check.Length(t, 2).Assert(s2)
+	b[0] = 1
+	s2.Refresh()
+	check.Length(t, 1).Assert(s2)
+	check.True(t).Assert(s2.Contains([]int{1}))
+}
+
+func Test_HashSet_CustomHasher(t *testing.T) {
+	s := With([]string{`Cat`, `dog`}, foldedHasher())
+	check.False(t).Assert(s.Add(`CAT`, `Dog`))
+	check.True(t).Assert(s.Contains(`cat`))
+	check.True(t).Assert(s.Remove(`DOG`))
+	check.String(t, `Cat`).Assert(s)
+
+	check.Equal(t, s.Hash()).Assert(With([]string{`cAt`}, foldedHasher()).Hash())
+	check.MatchError(t, `^invalid number of arguments \{count: 2, maximum: 1, usage: hasher\}$`).
+		Panic(func() { New(foldedHasher(), foldedHasher()) })
+}
+
+func Test_HashSet_Take(t *testing.T) {
+	all := []int{1, 2, 3, 4, 5, 6}
+	s := With(all)
+	v1 := s.TakeAny()
+	check.OneOf(t, all).Assert(v1)
+	check.False(t).Assert(s.Contains(v1))
+	check.Length(t, 5).Assert(s)
+
+	v234 := s.TakeMany(3)
+	check.Length(t, 3).Assert(v234)
+	check.OneOf(t, all).AssertAll(v234)
+	check.Length(t, 2).Assert(s)
+
+	v56 := s.TakeMany(3)
+	check.Length(t, 2).Assert(v56)
+	check.Length(t, 0).Assert(s)
+	check.Length(t, 0).Assert(s.TakeMany(3))
+
+	check.MatchError(t, `^collection contains no values \{action: TakeAny\}$`).Panic(func() {
+		s.TakeAny()
+	})
+}
+
+func Test_HashSet_OnChange(t *testing.T) {
+	buf := &bytes.Buffer{}
+	s := New[int]()
+	lis := listener.New(func(args collections.ChangeArgs) {
+		_, _ = buf.WriteString(args.Type().String())
+	})
+	defer lis.Cancel()
+	check.True(t).Assert(lis.Subscribe(s.OnChange()))
+
+	check.False(t).Assert(s.Add())
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(s.Add(1, 5))
+	check.StringAndReset(t, `Added`).Assert(buf)
+	check.False(t).Assert(s.AddFrom(enumerator.Enumerate(1, 5)))
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(s.AddFrom(enumerator.Enumerate(3, 2)))
+	check.StringAndReset(t, `Added`).Assert(buf)
+
+	check.False(t).Assert(s.Remove(4, 6))
+	check.StringAndReset(t, ``).Assert(buf)
+	check.True(t).Assert(s.Remove(2))
+	check.StringAndReset(t, `Removed`).Assert(buf)
+	check.True(t).Assert(s.RemoveIf(predicate.GreaterEq(3)))
+	check.StringAndReset(t, `Removed`).Assert(buf)
+	check.String(t, `1`).Assert(s)
+
+	s.Clear()
+	check.StringAndReset(t, `Removed`).Assert(buf)
+	s.Clear()
+	check.StringAndReset(t, ``).Assert(buf)
+}
+
+func Test_HashSet_EqualsAcrossImplementations(t *testing.T) {
+	s1 := With([]int{3, 1, 2})
+	s2 := set.With(1, 2, 3)
+	check.True(t).Assert(s1.Equals(s2))
+	check.True(t).Assert(s2.Equals(s1))
+	check.True(t).Assert(s1.Readonly().Equals(s2.Readonly()))
+	check.Equal(t, s1.Hash()).Assert(s2.Hash())
+
+	s2.Add(4)
+	check.False(t).Assert(s1.Equals(s2))
+	check.False(t).Assert(s2.Equals(s1))
+
+	l := list.With(s1.ToSlice()...)
+	check.False(t).Assert(s1.Equals(l))
+}
+
+func Test_HashSet_EqualsWithCustomHasher(t *testing.T) {
+	s1 := With([]string{`Cat`, `dog`}, foldedHasher())
+	s2 := set.With(`Cat`, `dog`)
+	s3 := With([]string{`Cat`, `dog`})
+	check.False(t).Assert(s1.Equals(s2))
+	check.False(t).Assert(s2.Equals(s1))
+	check.False(t).Assert(s1.Equals(s3))
+	check.False(t).Assert(s3.Equals(s1))
+	check.False(t).Assert(s3.Readonly().Equals(s1.Readonly()))
+	check.True(t).Assert(s3.Equals(s2))
+	check.Equal(t, s2.Hash()).Assert(s3.Hash())
+
+	s4 := With([]string{`CAT`, `Dog`}, foldedHasher())
+	check.True(t).Assert(s1.Equals(s4))
+	check.True(t).Assert(s4.Equals(s1))
+	check.True(t).Assert(s1.Readonly().Equals(s4.Readonly()))
+	check.Equal(t, s1.Hash()).Assert(s4.Hash())
+
+	lengthHasher := comp.NewHasher(func(value string) uint64 {
+		return uint64(len(value))
+	}, strings.EqualFold)
+	s5 := With([]string{`cat`, `DOG`}, lengthHasher)
+	check.False(t).Assert(s1.Equals(s5))
+	check.False(t).Assert(s5.Equals(s1))
+}
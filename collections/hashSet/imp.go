@@ -0,0 +1,220 @@
+package hashSet
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/changeArgs"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/collections/list"
+	"github.com/Snow-Gremlin/goToolbox/collections/readonlySet"
+	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
+	"github.com/Snow-Gremlin/goToolbox/internal/openTable"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type hashSetImp[T any] struct {
+	t      *openTable.Table[T, struct{}]
+	event  events.Event[collections.ChangeArgs]
+	custom bool
+}
+
+func (s *hashSetImp[T]) onAdded() {
+	if s.event != nil {
+		s.event.Invoke(changeArgs.NewAdded())
+	}
+}
+
+func (s *hashSetImp[T]) onRemoved() {
+	if s.event != nil {
+		s.event.Invoke(changeArgs.NewRemoved())
+	}
+}
+
+func (s *hashSetImp[T]) Enumerate() collections.Enumerator[T] {
+	// The values are collected once before iteration so that changes
+	// to the set, which may move values around in the table, may just
+	// cause the enumeration to be unstable but doesn't require it to be stopped.
+	return enumerator.New(func() collections.Iterator[T] {
+		values := s.t.Keys()
+		index, count := -1, len(values)-1
+		return iterator.New(func() (T, bool) {
+			if index < count {
+				index++
+				return values[index], true
+			}
+			return utils.Zero[T](), false
+		})
+	})
+}
+
+func (s *hashSetImp[T]) Empty() bool {
+	return s.t.Count() <= 0
+}
+
+func (s *hashSetImp[T]) Count() int {
+	return s.t.Count()
+}
+
+func (s *hashSetImp[T]) ToSlice() []T {
+	return s.t.Keys()
+}
+
+func (s *hashSetImp[T]) CopyToSlice(s2 []T) {
+	index, room := 0, len(s2)
+	s.t.Range(func(value T, _ struct{}) bool {
+		if index >= room {
+			return false
+		}
+		s2[index] = value
+		index++
+		return true
+	})
+}
+
+func (s *hashSetImp[T]) ToList() collections.List[T] {
+	return list.From(s.Enumerate())
+}
+
+func (s *hashSetImp[T]) Contains(value T) bool {
+	return s.t.Has(value)
+}
+
+func (s *hashSetImp[T]) String() string {
+	parts := utils.Strings(s.t.Keys())
+	slices.Sort(parts)
+	return strings.Join(parts, `, `)
+}
+
+func (s *hashSetImp[T]) CustomEquality() bool {
+	return s.custom
+}
+
+func (s *hashSetImp[T]) Equals(other any) bool {
+	s2, ok := other.(collections.ReadonlySet[T])
+	return ok && equality.Set[T](s, s2)
+}
+
+func (s *hashSetImp[T]) Hash() uint64 {
+	if !s.custom {
+		return equality.SetHash[T](s)
+	}
+	return equality.SetHashBy[T](s, s.t.Hasher().Hash)
+}
+
+func (s *hashSetImp[T]) OnChange() events.Event[collections.ChangeArgs] {
+	if s.event == nil {
+		s.event = event.New[collections.ChangeArgs]()
+	}
+	return s.event
+}
+
+func (s *hashSetImp[T]) Add(values ...T) bool {
+	added := false
+	for _, value := range values {
+		added = s.t.Add(value, struct{}{}) || added
+	}
+	if added {
+		s.onAdded()
+	}
+	return added
+}
+
+func (s *hashSetImp[T]) AddFrom(e collections.Enumerator[T]) bool {
+	if utils.IsNil(e) {
+		return false
+	}
+	added := false
+	it := e.Iterate()
+	for it.Next() {
+		added = s.t.Add(it.Current(), struct{}{}) || added
+	}
+	if added {
+		s.onAdded()
+	}
+	return added
+}
+
+func (s *hashSetImp[T]) TakeAny() T {
+	var result T
+	found := false
+	s.t.Range(func(value T, _ struct{}) bool {
+		result, found = value, true
+		return false
+	})
+	if !found {
+		panic(terror.EmptyCollection(`TakeAny`))
+	}
+	s.t.Remove(result)
+	s.onRemoved()
+	return result
+}
+
+func (s *hashSetImp[T]) TakeMany(count int) []T {
+	count = min(count, s.Count())
+	if count <= 0 {
+		return []T{}
+	}
+	results := make([]T, 0, count)
+	s.t.Range(func(value T, _ struct{}) bool {
+		results = append(results, value)
+		return len(results) < count
+	})
+	for _, value := range results {
+		s.t.Remove(value)
+	}
+	s.onRemoved()
+	return results
+}
+
+func (s *hashSetImp[T]) Remove(values ...T) bool {
+	removed := false
+	for _, value := range values {
+		removed = s.t.Remove(value) || removed
+	}
+	if removed {
+		s.onRemoved()
+	}
+	return removed
+}
+
+func (s *hashSetImp[T]) RemoveIf(predicate collections.Predicate[T]) bool {
+	if utils.IsNil(predicate) {
+		return false
+	}
+	if s.t.RemoveIf(func(value T, _ struct{}) bool { return predicate(value) }) {
+		s.onRemoved()
+		return true
+	}
+	return false
+}
+
+func (s *hashSetImp[T]) Refresh() {
+	if s.t.Rehash() {
+		s.onRemoved()
+	}
+}
+
+func (s *hashSetImp[T]) Clear() {
+	if s.t.Count() > 0 {
+		s.t.Clear()
+		s.onRemoved()
+	}
+}
+
+func (s *hashSetImp[T]) Clone() collections.Set[T] {
+	return &hashSetImp[T]{
+		t:      s.t.Clone(),
+		event:  nil,
+		custom: s.custom,
+	}
+}
+
+func (s *hashSetImp[T]) Readonly() collections.ReadonlySet[T] {
+	return readonlySet.New(s)
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/utils"
 )
 
 func toSlice_SimpleStack[T any](it collections.Iterator[T]) []T {
@@ -161,3 +162,128 @@ func Benchmark_Iterator_ToSlice_10000(b *testing.B) {
 func Benchmark_Iterator_ToSlice_100000(b *testing.B) {
 	toSlice_Comparison(b, 100000)
 }
+
+func pipeline_UnfusedWhere[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[T] {
+	return New(func() (T, bool) {
+		for it.Next() {
+			if value := it.Current(); p(value) {
+				return value, true
+			}
+		}
+		return utils.Zero[T](), false
+	})
+}
+
+func pipeline_UnfusedSelect[TIn, TOut any](it collections.Iterator[TIn], selector collections.Selector[TIn, TOut]) collections.Iterator[TOut] {
+	return New(func() (TOut, bool) {
+		if it.Next() {
+			return selector(it.Current()), true
+		}
+		return utils.Zero[TOut](), false
+	})
+}
+
+func pipeline_UnfusedTake[T any](it collections.Iterator[T], count int) collections.Iterator[T] {
+	return New(func() (T, bool) {
+		if count > 0 && it.Next() {
+			count--
+			return it.Current(), true
+		}
+		count = 0
+		return utils.Zero[T](), false
+	})
+}
+
+func pipeline_UnfusedIterate[T any](values ...T) collections.Iterator[T] {
+	index := -1
+	count := len(values)
+	return New(func() (T, bool) {
+		if count > 0 {
+			count--
+			index++
+			return values[index], true
+		}
+		return utils.Zero[T](), false
+	})
+}
+
+func pipeline_Comparison(b *testing.B, count int) {
+	src := make([]int, count)
+	for i := 0; i < count; i++ {
+		src[i] = int(rand.Int31())
+	}
+	isEven := func(value int) bool { return value%2 == 0 }
+	isSmall := func(value int) bool { return value%3 != 0 }
+	half := func(value int) int { return value / 2 }
+
+	var result1, result2 []int
+	b.Run(`Unfused`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			it := pipeline_UnfusedIterate(src...)
+			it = pipeline_UnfusedWhere(it, isEven)
+			it = pipeline_UnfusedSelect(it, half)
+			it = pipeline_UnfusedWhere(it, isSmall)
+			it = pipeline_UnfusedTake(it, count)
+			result1 = ToSlice(it)
+		}
+	})
+
+	b.Run(`Fused`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			it := Iterate(src...)
+			it = Where(it, isEven)
+			it = Select(it, half)
+			it = Where(it, isSmall)
+			it = Take(it, count)
+			result2 = ToSlice(it)
+		}
+	})
+
+	checkEqual(b, result1, result2)
+}
+
+func Benchmark_Iterator_Pipeline_10(b *testing.B) {
+	pipeline_Comparison(b, 10)
+}
+
+func Benchmark_Iterator_Pipeline_10000(b *testing.B) {
+	pipeline_Comparison(b, 10000)
+}
+
+func sliceSource_Comparison(b *testing.B, count int) {
+	src := make([]int, count)
+	for i := 0; i < count; i++ {
+		src[i] = int(rand.Int31())
+	}
+
+	var count1, count2 int
+	var last1, last2 int
+	b.Run(`Stepping`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			count1 = Count(pipeline_UnfusedIterate(src...))
+			last1, _ = Last(pipeline_UnfusedIterate(src...))
+			last1, _ = Get(pipeline_UnfusedIterate(src...), count-1)
+			count1 += Count(Skip(pipeline_UnfusedIterate(src...), count/2))
+		}
+	})
+
+	b.Run(`Slice Source`, func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			count2 = Count(Iterate(src...))
+			last2, _ = Last(Iterate(src...))
+			last2, _ = Get(Iterate(src...), count-1)
+			count2 += Count(Skip(Iterate(src...), count/2))
+		}
+	})
+
+	checkEqual(b, count1, count2)
+	checkEqual(b, last1, last2)
+}
+
+func Benchmark_Iterator_SliceSource_10000(b *testing.B) {
+	sliceSource_Comparison(b, 10000)
+}
@@ -0,0 +1,234 @@
+package iterator
+
+import (
+	"slices"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// checkSelectCount panics if the given number of values to select is negative.
+func checkSelectCount(k int) {
+	if k < 0 {
+		panic(terror.New(`the number of values to select may not be negative`).
+			With(`k`, k))
+	}
+}
+
+// pick creates a new slice of the values at the given indices.
+//
+// A new slice is created for each step, instead of reusing one buffer,
+// since the returned slices are commonly collected, e.g. with `ToSlice`,
+// or compared to prior slices, and a reused buffer would be overwritten
+// by the following steps without the caller being able to tell.
+func pick[T any](values []T, indices []int) []T {
+	result := make([]T, len(indices))
+	for i, index := range indices {
+		result[i] = values[index]
+	}
+	return result
+}
+
+// indexStepper creates an iterator which returns the values for the first
+// k indices of each set of indices. The first set of indices is returned first,
+// then step is called to advance the indices in place.
+// Step returns false when there are no more indices.
+func indexStepper[T any](values []T, first []int, k int, step func(indices []int) bool) collections.Iterator[[]T] {
+	indices := first
+	return New(func() ([]T, bool) {
+		if indices == nil {
+			return utils.Zero[[]T](), false
+		}
+		result := pick(values, indices[:k])
+		if !step(indices) {
+			indices = nil
+		}
+		return result, true
+	})
+}
+
+// Product creates an iterator for the cartesian product of the given slices
+// of values in lexicographic order, where the last slice varies the fastest.
+// Each returned slice has one value from each of the given slices.
+// If any of the given slices is empty then there are no values.
+// If no slices are given then one empty slice is returned.
+func Product[T any](values ...[]T) collections.Iterator[[]T] {
+	for _, v := range values {
+		if len(v) <= 0 {
+			return Iterate[[]T]()
+		}
+	}
+
+	indices := make([]int, len(values))
+	done := false
+	return New(func() ([]T, bool) {
+		if done {
+			return utils.Zero[[]T](), false
+		}
+		result := make([]T, len(values))
+		for i, index := range indices {
+			result[i] = values[i][index]
+		}
+		done = true
+		for i := len(indices) - 1; i >= 0; i-- {
+			indices[i]++
+			if indices[i] < len(values[i]) {
+				done = false
+				break
+			}
+			indices[i] = 0
+		}
+		return result, true
+	})
+}
+
+// Permutations creates an iterator for all the ordered arrangements of
+// the given number of values chosen from the given values.
+// The arrangements are returned in lexicographic order of the value positions.
+// Values are chosen by position so duplicate values will create duplicate arrangements.
+// If k is greater than the number of values then there are no arrangements.
+//
+// This will panic if k is negative.
+func Permutations[T any](values []T, k int) collections.Iterator[[]T] {
+	checkSelectCount(k)
+	n := len(values)
+	if k > n {
+		return Iterate[[]T]()
+	}
+
+	// All n indices are kept so the values after the first k are
+	// the unused indices in ascending order.
+	indices := make([]int, n)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indexStepper(values, indices, k, func(indices []int) bool {
+		// Reversing the unused indices makes the next full permutation
+		// the next permutation of the first k indices.
+		slices.Reverse(indices[k:])
+		return nextPermutation(indices)
+	})
+}
+
+// nextPermutation advances the given indices to the next lexicographic permutation.
+// Returns false if the indices are already the last permutation.
+func nextPermutation(indices []int) bool {
+	i := len(indices) - 2
+	for i >= 0 && indices[i] >= indices[i+1] {
+		i--
+	}
+	if i < 0 {
+		return false
+	}
+	j := len(indices) - 1
+	for indices[j] <= indices[i] {
+		j--
+	}
+	indices[i], indices[j] = indices[j], indices[i]
+	slices.Reverse(indices[i+1:])
+	return true
+}
+
+// Combinations creates an iterator for all the unordered selections of
+// the given number of values chosen from the given values without repeats.
+// The selections are returned in lexicographic order of the value positions
+// and the values in each selection are in the same order as the given values.
+// Values are chosen by position so duplicate values will create duplicate selections.
+// If k is greater than the number of values then there are no selections.
+//
+// This will panic if k is negative.
+func Combinations[T any](values []T, k int) collections.Iterator[[]T] {
+	checkSelectCount(k)
+	n := len(values)
+	if k > n {
+		return Iterate[[]T]()
+	}
+
+	indices := make([]int, k)
+	for i := range indices {
+		indices[i] = i
+	}
+	return indexStepper(values, indices, k, func(indices []int) bool {
+		i := k - 1
+		for i >= 0 && indices[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return false
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+		return true
+	})
+}
+
+// CombinationsWithReplacement creates an iterator for all the unordered
+// selections of the given number of values chosen from the given values
+// where each value may be chosen more than once.
+// The selections are returned in lexicographic order of the value positions
+// and the values in each selection are in the same order as the given values.
+// If there are no values then there are no selections unless k is zero.
+//
+// This will panic if k is negative.
+func CombinationsWithReplacement[T any](values []T, k int) collections.Iterator[[]T] {
+	checkSelectCount(k)
+	n := len(values)
+	if n <= 0 && k > 0 {
+		return Iterate[[]T]()
+	}
+
+	return indexStepper(values, make([]int, k), k, func(indices []int) bool {
+		i := k - 1
+		for i >= 0 && indices[i] == n-1 {
+			i--
+		}
+		if i < 0 {
+			return false
+		}
+		indices[i]++
+		for j := i + 1; j < k; j++ {
+			indices[j] = indices[i]
+		}
+		return true
+	})
+}
+
+// PowerSet creates an iterator for all the subsets of the given values,
+// starting with the empty subset. The subsets are returned in lexicographic
+// order of the value positions and the values in each subset are in the same
+// order as the given values. Values are chosen by position so duplicate values
+// will create duplicate subsets.
+func PowerSet[T any](values []T) collections.Iterator[[]T] {
+	n := len(values)
+	indices := make([]int, 0, n)
+	done := false
+	return New(func() ([]T, bool) {
+		if done {
+			return utils.Zero[[]T](), false
+		}
+		result := pick(values, indices)
+
+		// Extend the subset with the next position if there is one,
+		// otherwise drop the last position and advance the new last position.
+		count := len(indices)
+		switch {
+		case count <= 0:
+			if n <= 0 {
+				done = true
+			} else {
+				indices = append(indices, 0)
+			}
+		case indices[count-1] < n-1:
+			indices = append(indices, indices[count-1]+1)
+		case count <= 1:
+			done = true
+		default:
+			indices = indices[:count-1]
+			indices[count-2]++
+		}
+		return result, true
+	})
+}
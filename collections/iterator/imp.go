@@ -7,19 +7,23 @@ type iteratorImp[T any] struct {
 	current T
 }
 
-func (it *iteratorImp[T]) Next() bool {
+func (it *iteratorImp[T]) fetch() (T, bool) {
 	if it.fetcher == nil {
-		return false
+		return utils.Zero[T](), false
 	}
 
 	if next, has := it.fetcher(); has {
-		it.current = next
-		return true
+		return next, true
 	}
 
-	it.current = utils.Zero[T]()
 	it.fetcher = nil
-	return false
+	return utils.Zero[T](), false
+}
+
+func (it *iteratorImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
 }
 
 func (it *iteratorImp[T]) Current() T {
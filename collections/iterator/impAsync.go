@@ -0,0 +1,59 @@
+package iterator
+
+import (
+	"context"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type asyncImp[T any] struct {
+	source  collections.Iterator[T]
+	buffer  int
+	ch      <-chan T
+	wait    func()
+	cancel  context.CancelFunc
+	current T
+	done    bool
+}
+
+func (it *asyncImp[T]) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if it.ch == nil {
+		var ctx context.Context
+		ctx, it.cancel = context.WithCancel(context.Background())
+		it.ch, it.wait = ToChan(ctx, it.source, it.buffer)
+	}
+
+	if value, ok := <-it.ch; ok {
+		it.current = value
+		return true
+	}
+
+	_ = it.Close()
+	it.wait() // re-panics if the producer panicked
+	return false
+}
+
+func (it *asyncImp[T]) Current() T {
+	return it.current
+}
+
+func (it *asyncImp[T]) Close() error {
+	if !it.done {
+		it.done = true
+		it.current = utils.Zero[T]()
+		if it.cancel != nil {
+			// The producer closes the source once it stops.
+			it.cancel()
+			it.cancel = nil
+		} else {
+			release(it.source)
+		}
+		it.source = nil
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package iterator
+
+import (
+	"errors"
+	"io"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// closableImp is an iterator using a fetcher which holds onto resources.
+// The resources are released by the closer the first time the iterator
+// is closed or when the fetcher has no more values.
+type closableImp[T any] struct {
+	iteratorImp[T]
+	closer func() error
+}
+
+func (it *closableImp[T]) fetch() (T, bool) {
+	if next, has := it.iteratorImp.fetch(); has {
+		return next, true
+	}
+	_ = it.Close()
+	return utils.Zero[T](), false
+}
+
+func (it *closableImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *closableImp[T]) Close() error {
+	it.fetcher = nil
+	it.current = utils.Zero[T]()
+	if closer := it.closer; closer != nil {
+		it.closer = nil
+		return closer()
+	}
+	return nil
+}
+
+// wrap creates an iterator using the given fetcher which reads values
+// from the given sources. If any of the sources can be closed, then the
+// returned iterator can be closed and it will close those sources when
+// it is closed or when the fetcher has no more values.
+func wrap[T any](fetcher Fetcher[T], sources ...any) collections.Iterator[T] {
+	closers := make([]io.Closer, 0, len(sources))
+	for _, source := range sources {
+		if c, ok := source.(io.Closer); ok {
+			closers = append(closers, c)
+		}
+	}
+	if len(closers) == 0 {
+		return New(fetcher)
+	}
+	return NewClosable(fetcher, func() error {
+		errs := make([]error, len(closers))
+		for i, c := range closers {
+			errs[i] = c.Close()
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// sourcesOf gets the given iterators as sources for `wrap`.
+func sourcesOf[T any](its []collections.Iterator[T]) []any {
+	sources := make([]any, len(its))
+	for i, it := range its {
+		sources[i] = it
+	}
+	return sources
+}
+
+// closeSource closes the given source if it can be closed.
+func closeSource(source any) error {
+	if c, ok := source.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// release closes the given iterator, if it can be closed, once a terminal
+// operator is done with it. Any error from closing is ignored since
+// the terminal operators have no way to return it.
+func release(it any) {
+	_ = closeSource(it)
+}
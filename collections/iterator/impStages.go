@@ -0,0 +1,300 @@
+package iterator
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// fetchable is an iterator which can fetch its next value without
+// storing it as the current value.
+//
+// The stages of a pipeline fetch values directly from the stage before them
+// so that each value is passed along without each stage having to step
+// through the prior stage with `Next` and `Current`.
+// Closing a stage closes the stage before it, so that a closable source
+// at the start of a pipeline is closed when the end of the pipeline is.
+type fetchable[T any] interface {
+	collections.Iterator[T]
+
+	// fetch gets the next value and true,
+	// or zero and false if there are no more values.
+	fetch() (T, bool)
+}
+
+// remainder is an iterator which may be able to take its next values
+// as a slice without stepping through each value.
+type remainder[T any] interface {
+	// remaining takes up to the given limit of the next values,
+	// or all the remaining values if the limit is negative.
+	// Returns false and takes no values if the values can not be
+	// taken as a slice. The returned slice must not be modified.
+	remaining(limit int) ([]T, bool)
+}
+
+// fetchableOf gets the given iterator as a fetchable iterator.
+func fetchableOf[T any](it collections.Iterator[T]) fetchable[T] {
+	if f, ok := it.(fetchable[T]); ok {
+		return f
+	}
+	return &adaptImp[T]{Iterator: it}
+}
+
+// lastTaken gets the value which should be current after taking the given
+// values with the given limit. When fewer values than the limit were taken,
+// the iterator has been read to the end so the current is zero, the same
+// as when `Next` returns false, otherwise it is the last value taken.
+func lastTaken[T any](values []T, limit int) T {
+	if limit < 0 || len(values) < limit || len(values) <= 0 {
+		return utils.Zero[T]()
+	}
+	return values[len(values)-1]
+}
+
+// remainingOf takes all the remaining values from the given iterator as a slice
+// if the iterator supports it, otherwise false is returned and no values are read.
+func remainingOf[T any](it collections.Iterator[T], limit int) ([]T, bool) {
+	if r, ok := it.(remainder[T]); ok {
+		return r.remaining(limit)
+	}
+	return nil, false
+}
+
+// adaptImp is a fetchable iterator for any other iterator.
+type adaptImp[T any] struct {
+	collections.Iterator[T]
+}
+
+func (it *adaptImp[T]) Close() error {
+	return closeSource(it.Iterator)
+}
+
+func (it *adaptImp[T]) fetch() (T, bool) {
+	if it.Next() {
+		return it.Current(), true
+	}
+	return utils.Zero[T](), false
+}
+
+// sliceImp is an iterator over the values in a slice.
+type sliceImp[T any] struct {
+	values  []T
+	current T
+}
+
+func (it *sliceImp[T]) fetch() (T, bool) {
+	if len(it.values) > 0 {
+		value := it.values[0]
+		it.values = it.values[1:]
+		return value, true
+	}
+	it.values = nil
+	return utils.Zero[T](), false
+}
+
+func (it *sliceImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *sliceImp[T]) Current() T {
+	return it.current
+}
+
+func (it *sliceImp[T]) remaining(limit int) ([]T, bool) {
+	count := len(it.values)
+	if limit >= 0 {
+		count = min(count, limit)
+	}
+	values := it.values[:count:count]
+	it.values = it.values[count:]
+	it.current = lastTaken(values, limit)
+	return values, true
+}
+
+// whereImp is an iterator stage which filters the values.
+type whereImp[T any] struct {
+	source  fetchable[T]
+	p       collections.Predicate[T]
+	current T
+}
+
+func (it *whereImp[T]) fetch() (T, bool) {
+	for {
+		value, ok := it.source.fetch()
+		if !ok || it.p(value) {
+			return value, ok
+		}
+	}
+}
+
+func (it *whereImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *whereImp[T]) Current() T {
+	return it.current
+}
+
+func (it *whereImp[T]) Close() error {
+	return closeSource(it.source)
+}
+
+// selectImp is an iterator stage which converts the values.
+// When selecting from a filter stage, the filter is fused into
+// this stage so that the values are filtered and converted in one step.
+type selectImp[TIn, TOut any] struct {
+	source   fetchable[TIn]
+	p        collections.Predicate[TIn]
+	selector collections.Selector[TIn, TOut]
+	current  TOut
+}
+
+func newSelect[TIn, TOut any](it collections.Iterator[TIn], selector collections.Selector[TIn, TOut]) collections.Iterator[TOut] {
+	if w, ok := it.(*whereImp[TIn]); ok {
+		return &selectImp[TIn, TOut]{
+			source:   w.source,
+			p:        w.p,
+			selector: selector,
+			current:  utils.Zero[TOut](),
+		}
+	}
+	return &selectImp[TIn, TOut]{
+		source:   fetchableOf(it),
+		p:        nil,
+		selector: selector,
+		current:  utils.Zero[TOut](),
+	}
+}
+
+func (it *selectImp[TIn, TOut]) fetch() (TOut, bool) {
+	for {
+		value, ok := it.source.fetch()
+		if !ok {
+			return utils.Zero[TOut](), false
+		}
+		if it.p == nil || it.p(value) {
+			return it.selector(value), true
+		}
+	}
+}
+
+func (it *selectImp[TIn, TOut]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *selectImp[TIn, TOut]) Current() TOut {
+	return it.current
+}
+
+func (it *selectImp[TIn, TOut]) Close() error {
+	return closeSource(it.source)
+}
+
+// takeImp is an iterator stage which stops after a number of values.
+type takeImp[T any] struct {
+	source  fetchable[T]
+	count   int
+	current T
+}
+
+func (it *takeImp[T]) fetch() (T, bool) {
+	if it.count > 0 {
+		if value, ok := it.source.fetch(); ok {
+			it.count--
+			return value, true
+		}
+	}
+	it.count = 0
+	return utils.Zero[T](), false
+}
+
+func (it *takeImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *takeImp[T]) Current() T {
+	return it.current
+}
+
+func (it *takeImp[T]) Close() error {
+	return closeSource(it.source)
+}
+
+func (it *takeImp[T]) remaining(limit int) ([]T, bool) {
+	if limit < 0 || limit > it.count {
+		limit = it.count
+	}
+	values, ok := remainingOf(it.source, limit)
+	if ok {
+		it.count -= len(values)
+		if len(values) < limit {
+			it.count = 0
+		}
+		it.current = lastTaken(values, limit)
+	}
+	return values, ok
+}
+
+// skipImp is an iterator stage which skips over a number of values
+// before returning the rest. The values are skipped when the first
+// value is read, and if the source is a slice, the skipped values
+// are jumped over without reading each one.
+type skipImp[T any] struct {
+	source  fetchable[T]
+	count   int
+	current T
+}
+
+func (it *skipImp[T]) skip() {
+	if it.count <= 0 {
+		return
+	}
+	if _, ok := remainingOf(it.source, it.count); !ok {
+		for ; it.count > 0; it.count-- {
+			if _, ok := it.source.fetch(); !ok {
+				break
+			}
+		}
+	}
+	it.count = 0
+}
+
+func (it *skipImp[T]) fetch() (T, bool) {
+	it.skip()
+	return it.source.fetch()
+}
+
+func (it *skipImp[T]) Next() bool {
+	var has bool
+	it.current, has = it.fetch()
+	return has
+}
+
+func (it *skipImp[T]) Current() T {
+	return it.current
+}
+
+func (it *skipImp[T]) Close() error {
+	return closeSource(it.source)
+}
+
+func (it *skipImp[T]) remaining(limit int) ([]T, bool) {
+	if it.count > 0 {
+		if _, ok := it.source.(remainder[T]); !ok {
+			return nil, false
+		}
+		it.skip()
+	}
+	values, ok := remainingOf(it.source, limit)
+	if ok {
+		it.current = lastTaken(values, limit)
+	}
+	return values, ok
+}
@@ -1,6 +1,8 @@
 package iterator
 
 import (
+	"context"
+	"errors"
 	"iter"
 	"reflect"
 	"slices"
@@ -9,6 +11,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
 	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/openTable"
 	"github.com/Snow-Gremlin/goToolbox/internal/optional"
 	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
@@ -24,18 +27,41 @@ func New[T any](fetcher Fetcher[T]) collections.Iterator[T] {
 	}
 }
 
+// NewClosable creates a new iterator for stepping through values using a fetcher
+// from a source which holds onto resources, such as file handles or goroutines.
+// As soon as the fetcher returns false this iterator will stop.
+//
+// The given closer is called to release the resources the first time
+// the iterator is closed or when the fetcher returns false.
+// The closer may be nil if there is nothing to release.
+func NewClosable[T any](fetcher Fetcher[T], closer func() error) collections.ClosableIterator[T] {
+	return &closableImp[T]{
+		iteratorImp: iteratorImp[T]{
+			fetcher: fetcher,
+			current: utils.Zero[T](),
+		},
+		closer: closer,
+	}
+}
+
+// Close closes the given iterator if it is an `io.Closer`,
+// otherwise this has no effect and returns nil.
+//
+// Iterators read from closable iterators, such as those from `Where`,
+// `Select`, and `Take`, can be closed to close the iterators they read from.
+// The terminal operators, such as `First`, `Any`, and `ToSlice`, close
+// the iterator they are given once they are done reading from it,
+// even if they stop before all the values have been read.
+func Close[T any](it collections.Iterator[T]) error {
+	return closeSource(it)
+}
+
 // Iterate will iterate the given values.
 func Iterate[T any](values ...T) collections.Iterator[T] {
-	index := -1
-	count := len(values)
-	return New(func() (T, bool) {
-		if count > 0 {
-			count--
-			index++
-			return values[index], true
-		}
-		return utils.Zero[T](), false
-	})
+	return &sliceImp[T]{
+		values:  values,
+		current: utils.Zero[T](),
+	}
 }
 
 // Range will iterate from the given count of values.
@@ -70,17 +96,122 @@ func Repeat[T any](value T, count int) collections.Iterator[T] {
 	})
 }
 
-// Where creates an iterator which reads from the given iterator
-// but only returns values which the given predicate returns true for.
-func Where[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[T] {
+// FromChan creates an iterator which reads values from the given channel
+// until the channel is closed.
+//
+// A channel can only be read once, so values read by this iterator
+// will not be read again by any other iterator from the same channel.
+func FromChan[T any](ch <-chan T) collections.Iterator[T] {
 	return New(func() (T, bool) {
-		for it.Next() {
-			if value := it.Current(); p(value) {
-				return value, true
+		value, ok := <-ch
+		return value, ok
+	})
+}
+
+// FromChanCtx creates an iterator which reads values from the given channel
+// until the channel is closed or the given context is done.
+//
+// A channel can only be read once, so values read by this iterator
+// will not be read again by any other iterator from the same channel.
+func FromChanCtx[T any](ctx context.Context, ch <-chan T) collections.Iterator[T] {
+	return New(func() (T, bool) {
+		if ctx.Err() != nil {
+			return utils.Zero[T](), false
+		}
+		select {
+		case value, ok := <-ch:
+			return value, ok
+		case <-ctx.Done():
+			return utils.Zero[T](), false
+		}
+	})
+}
+
+// ToChan starts a producer goroutine which reads all the values from the given
+// iterator and writes them to the returned channel with the given buffer size.
+// The channel is closed once the iterator has no more values
+// or the given context is done.
+//
+// If the channel will not be read to the end, cancel the context
+// to stop the producer goroutine, otherwise the producer will be leaked.
+// The given iterator is closed, if it can be closed, when the producer stops.
+//
+// The returned wait function blocks until the producer has stopped.
+// If the iterator panics, the panic is recovered on the producer goroutine,
+// the channel is closed, and wait re-panics with the recovered panic as a terror
+// so that the panic is raised where the values are being consumed.
+func ToChan[T any](ctx context.Context, it collections.Iterator[T], buffer int) (<-chan T, func()) {
+	ch := make(chan T, max(0, buffer))
+	stopped := make(chan struct{})
+	var failure error
+	go func() {
+		defer close(stopped)
+		defer close(ch)
+		defer release(it)
+		defer func() {
+			if r := recover(); r != nil {
+				failure = terror.New(`producer failed`, terror.RecoveredPanic(r))
+			}
+		}()
+
+		for ctx.Err() == nil && it.Next() {
+			select {
+			case ch <- it.Current():
+			case <-ctx.Done():
+				return
 			}
 		}
+	}()
+	return ch, func() {
+		<-stopped
+		if failure != nil {
+			panic(failure)
+		}
+	}
+}
+
+// Async creates an iterator which reads the values from the given iterator
+// on a producer goroutine so that values may be read ahead of the consumer.
+// Up to the given buffer size of values will be read ahead.
+//
+// The producer isn't started until the first value is read.
+// If the returned iterator will not be read to the end,
+// it must be closed to stop the producer goroutine.
+// If the given iterator panics on the producer goroutine, the panic is
+// recovered and re-panicked, as a terror, when reading the returned iterator.
+func Async[T any](it collections.Iterator[T], buffer int) collections.ClosableIterator[T] {
+	return &asyncImp[T]{
+		source:  it,
+		buffer:  buffer,
+		ch:      nil,
+		wait:    nil,
+		cancel:  nil,
+		current: utils.Zero[T](),
+		done:    false,
+	}
+}
+
+// WithContext creates an iterator which reads the values from the given
+// iterator until the given context is done. The context is checked before
+// each value is read. Once the context is done, this iterator stops
+// and the given iterator is closed, if it can be closed.
+func WithContext[T any](ctx context.Context, it collections.Iterator[T]) collections.Iterator[T] {
+	return wrap(func() (T, bool) {
+		if ctx.Err() == nil && it.Next() {
+			return it.Current(), true
+		}
 		return utils.Zero[T](), false
-	})
+	}, it)
+}
+
+// Where creates an iterator which reads from the given iterator
+// but only returns values which the given predicate returns true for.
+func Where[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[T] {
+	return &whereImp[T]{
+		source:  fetchableOf(it),
+		p:       p,
+		current: utils.Zero[T](),
+	}
 }
 
 // WhereNot creates an iterator which reads from the given iterator
@@ -91,6 +222,10 @@ func WhereNot[T any](it collections.Iterator[T], p collections.Predicate[T]) col
 
 // ToSlice reads all the values out of the given iterator and returns them as a slice.
 func ToSlice[T any](it collections.Iterator[T]) []T {
+	defer release(it)
+	if values, ok := remainingOf(it, -1); ok {
+		return append([]T{}, values...)
+	}
 	s := []T{}
 	for it.Next() {
 		s = append(s, it.Current())
@@ -101,6 +236,7 @@ func ToSlice[T any](it collections.Iterator[T]) []T {
 // CopyToSlice reads values out of the given iterator adds them to the given slice.
 // This will stop when either values run out or there is no more room in the slice.
 func CopyToSlice[T any](it collections.Iterator[T], s []T) {
+	defer release(it)
 	for i, room := 0, len(s); i < room && it.Next(); i++ {
 		s[i] = it.Current()
 	}
@@ -108,6 +244,7 @@ func CopyToSlice[T any](it collections.Iterator[T], s []T) {
 
 // Foreach runs the given function for each values from the given iterator.
 func Foreach[T any](it collections.Iterator[T], m func(value T)) {
+	defer release(it)
 	for it.Next() {
 		m(it.Current())
 	}
@@ -117,6 +254,7 @@ func Foreach[T any](it collections.Iterator[T], m func(value T)) {
 // When an error is returned by the selector, the iteration ends and
 // returns that error. If no error is hit, nil is returned.
 func DoUntilError[T any](it collections.Iterator[T], s collections.Selector[T, error]) error {
+	defer release(it)
 	for it.Next() {
 		if err := s(it.Current()); err != nil {
 			return err
@@ -129,6 +267,7 @@ func DoUntilError[T any](it collections.Iterator[T], s collections.Selector[T, e
 // When a non-zero value is returned by the selector, the iteration ends and
 // returns that non-zero value. If no non-zero value is hit, a zero value is returned.
 func DoUntilNotZero[TIn, TOut any](it collections.Iterator[TIn], s collections.Selector[TIn, TOut]) TOut {
+	defer release(it)
 	for it.Next() {
 		if v := s(it.Current()); !utils.IsZero(v) {
 			return v
@@ -141,6 +280,7 @@ func DoUntilNotZero[TIn, TOut any](it collections.Iterator[TIn], s collections.S
 // the given predicate to return true, then true is returned.
 // If the predicated returns false for all values, then false is returned.
 func Any[T any](it collections.Iterator[T], p collections.Predicate[T]) bool {
+	defer release(it)
 	for it.Next() {
 		if p(it.Current()) {
 			return true
@@ -153,6 +293,7 @@ func Any[T any](it collections.Iterator[T], p collections.Predicate[T]) bool {
 // the given predicate to return false, then false is returned.
 // If the predicated returns true for all values, then true is returned.
 func All[T any](it collections.Iterator[T], p collections.Predicate[T]) bool {
+	defer release(it)
 	for it.Next() {
 		if !p(it.Current()) {
 			return false
@@ -168,6 +309,7 @@ func All[T any](it collections.Iterator[T], p collections.Predicate[T]) bool {
 // if the first value satisfies the predicate then this will return zero.
 // If no value satisfies the predicate then -1 is returned.
 func StepsUntil[T any](it collections.Iterator[T], p collections.Predicate[T]) int {
+	defer release(it)
 	count := 0
 	for it.Next() {
 		if p(it.Current()) {
@@ -180,6 +322,8 @@ func StepsUntil[T any](it collections.Iterator[T], p collections.Predicate[T]) i
 
 // StartsWith determines if the first iterator starts with the given prefix.
 func StartsWith[T any](it, prefix collections.Iterator[T]) bool {
+	defer release(it)
+	defer release(prefix)
 	for {
 		n1, n2 := it.Next(), prefix.Next()
 		if !n1 {
@@ -196,6 +340,8 @@ func StartsWith[T any](it, prefix collections.Iterator[T]) bool {
 
 // Equal determines if the two iterators contain the same values.
 func Equal[T any](it1, it2 collections.Iterator[T]) bool {
+	defer release(it1)
+	defer release(it2)
 	for {
 		next1, next2 := it1.Next(), it2.Next()
 		if !next1 {
@@ -210,12 +356,17 @@ func Equal[T any](it1, it2 collections.Iterator[T]) bool {
 // Empty attempts to read the next value off the given iterator.
 // If a value exists, the iterator isn't empty, otherwise false.
 func Empty[T any](it collections.Iterator[T]) bool {
+	defer release(it)
 	return !it.Next()
 }
 
 // Count reads all the values from the given iterator and
 // returns how many values were in the iterator.
 func Count[T any](it collections.Iterator[T]) int {
+	defer release(it)
+	if values, ok := remainingOf(it, -1); ok {
+		return len(values)
+	}
 	count := 0
 	for it.Next() {
 		count++
@@ -226,6 +377,7 @@ func Count[T any](it collections.Iterator[T]) int {
 // AtLeast reads only enough values from the given iterator to determine
 // if there is at least the given number of values exists.
 func AtLeast[T any](it collections.Iterator[T], minValue int) bool {
+	defer release(it)
 	count := 0
 	for it.Next() {
 		count++
@@ -239,6 +391,7 @@ func AtLeast[T any](it collections.Iterator[T], minValue int) bool {
 // AtMost reads all the values from the given iterator to determine
 // if there is at most the given number of values exists.
 func AtMost[T any](it collections.Iterator[T], maxValue int) bool {
+	defer release(it)
 	count := 0
 	for it.Next() {
 		count++
@@ -252,6 +405,7 @@ func AtMost[T any](it collections.Iterator[T], maxValue int) bool {
 // First reads one value off the given iterator, if one exists,
 // otherwise the zero value is returned.
 func First[T any](it collections.Iterator[T]) (T, bool) {
+	defer release(it)
 	if it.Next() {
 		return it.Current(), true
 	}
@@ -261,6 +415,13 @@ func First[T any](it collections.Iterator[T]) (T, bool) {
 // Last reads all the values off the given iterator and returns the last value.
 // Returns zero and false if there were no values in the iterator.
 func Last[T any](it collections.Iterator[T]) (T, bool) {
+	defer release(it)
+	if values, ok := remainingOf(it, -1); ok {
+		if count := len(values); count > 0 {
+			return values[count-1], true
+		}
+		return utils.Zero[T](), false
+	}
 	found := false
 	last := utils.Zero[T]()
 	for it.Next() {
@@ -270,9 +431,32 @@ func Last[T any](it collections.Iterator[T]) (T, bool) {
 	return last, found
 }
 
+// Get reads values off the given iterator to get the value at the given index,
+// where zero is the next value. Returns zero and false if the index is negative
+// or there are not enough values in the iterator.
+func Get[T any](it collections.Iterator[T], index int) (T, bool) {
+	defer release(it)
+	if index < 0 {
+		return utils.Zero[T](), false
+	}
+	if values, ok := remainingOf(it, index); ok {
+		if len(values) < index {
+			return utils.Zero[T](), false
+		}
+		return First(it)
+	}
+	for ; index > 0; index-- {
+		if !it.Next() {
+			return utils.Zero[T](), false
+		}
+	}
+	return First(it)
+}
+
 // Single reads two values off the given iterator.
 // If there is only one, then it is returned.
 func Single[T any](it collections.Iterator[T]) (T, bool) {
+	defer release(it)
 	if it.Next() {
 		value := it.Current()
 		if !it.Next() {
@@ -286,24 +470,18 @@ func Single[T any](it collections.Iterator[T]) (T, bool) {
 // from the given iterator before iterating the remaining values.
 // The skipped values aren't read until the first value read from the returned iterator.
 func Skip[T any](it collections.Iterator[T], count int) collections.Iterator[T] {
-	return New(func() (T, bool) {
-		for ; count > 0; count-- {
-			if !it.Next() {
-				return utils.Zero[T](), false
-			}
-		}
-		if it.Next() {
-			return it.Current(), true
-		}
-		return utils.Zero[T](), false
-	})
+	return &skipImp[T]{
+		source:  fetchableOf(it),
+		count:   count,
+		current: utils.Zero[T](),
+	}
 }
 
 // SkipWhile creates a new iterator which skips over values until the given predicate returns false.
 // The values from the given iterator are returned after and including the first false from the predicate.
 // The skipped values aren't read until the first value read from the returned iterator.
 func SkipWhile[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[T] {
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if p != nil {
 			for it.Next() {
 				if value := it.Current(); !p(value) {
@@ -318,26 +496,23 @@ func SkipWhile[T any](it collections.Iterator[T], p collections.Predicate[T]) co
 			return it.Current(), true
 		}
 		return utils.Zero[T](), false
-	})
+	}, it)
 }
 
 // Take creates a new iterator which only takes the given count of values
 // form the given iterator before stopping iteration.
 func Take[T any](it collections.Iterator[T], count int) collections.Iterator[T] {
-	return New(func() (T, bool) {
-		if count > 0 && it.Next() {
-			count--
-			return it.Current(), true
-		}
-		count = 0
-		return utils.Zero[T](), false
-	})
+	return &takeImp[T]{
+		source:  fetchableOf(it),
+		count:   max(count, 0),
+		current: utils.Zero[T](),
+	}
 }
 
 // TakeWhile creates a new iterator which only takes values until the given predicate returns false.
 // The values from the given iterator are returned until and excluding the first false from the predicate.
 func TakeWhile[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[T] {
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if p != nil && it.Next() {
 			if value := it.Current(); p(value) {
 				return value, true
@@ -345,19 +520,14 @@ func TakeWhile[T any](it collections.Iterator[T], p collections.Predicate[T]) co
 		}
 		p = nil
 		return utils.Zero[T](), false
-	})
+	}, it)
 }
 
 // Replace creates a new iterator which checks each value using the given replacer.
 // This gives an opportunity for values to be replaced with a different value or returned unchanged
 // whilst iterating over the values from the given iterator.
 func Replace[T any](it collections.Iterator[T], replacer collections.Selector[T, T]) collections.Iterator[T] {
-	return New(func() (T, bool) {
-		if it.Next() {
-			return replacer(it.Current()), true
-		}
-		return utils.Zero[T](), false
-	})
+	return newSelect(it, replacer)
 }
 
 // Reverse reads all the values from the given iterator and returns them
@@ -373,7 +543,7 @@ func Reverse[T any](it collections.Iterator[T]) collections.Iterator[T] {
 	first := true
 	var index int
 	var values []T
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if first {
 			first = false
 			values = ToSlice(it)
@@ -385,13 +555,13 @@ func Reverse[T any](it collections.Iterator[T]) collections.Iterator[T] {
 		}
 		values = nil
 		return utils.Zero[T](), false
-	})
+	}, it)
 }
 
 // Append creates an iterator with the given value appended to the end of the values.
 func Append[T any](it collections.Iterator[T], tails []T) collections.Iterator[T] {
 	index, count := 0, len(tails)
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if it.Next() {
 			return it.Current(), true
 		}
@@ -401,20 +571,20 @@ func Append[T any](it collections.Iterator[T], tails []T) collections.Iterator[T
 			return tail, true
 		}
 		return utils.Zero[T](), false
-	})
+	}, it)
 }
 
 // Concat concatenates the given iterators into one iterator.
 func Concat[T any](its []collections.Iterator[T]) collections.Iterator[T] {
 	index, count := 0, len(its)
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		for ; index < count; index++ {
 			if it := its[index]; it.Next() {
 				return it.Current(), true
 			}
 		}
 		return utils.Zero[T](), false
-	})
+	}, sourcesOf(its)...)
 }
 
 // Select changes one iterator type into another by converting each value.
@@ -423,24 +593,19 @@ func Select[TIn, TOut any](
 	it collections.Iterator[TIn],
 	selector collections.Selector[TIn, TOut],
 ) collections.Iterator[TOut] {
-	return New(func() (TOut, bool) {
-		if it.Next() {
-			return selector(it.Current()), true
-		}
-		return utils.Zero[TOut](), false
-	})
+	return newSelect(it, selector)
 }
 
 // OfType returns only the values of the given out type.
 func OfType[TIn, TOut any](it collections.Iterator[TIn]) collections.Iterator[TOut] {
-	return New(func() (TOut, bool) {
+	return wrap(func() (TOut, bool) {
 		for it.Next() {
 			if target, ok := any(it.Current()).(TOut); ok {
 				return target, true
 			}
 		}
 		return utils.Zero[TOut](), false
-	})
+	}, it)
 }
 
 // tryCast will attempt to convert the given value into the given target type.
@@ -460,7 +625,7 @@ func tryCast[TIn, TOut any](value TIn, target reflect.Type) (result TOut, ok boo
 // If a cast isn't possible then zero is returned.
 func Cast[TIn, TOut any](it collections.Iterator[TIn]) collections.Iterator[TOut] {
 	target := utils.TypeOf[TOut]()
-	return New(func() (TOut, bool) {
+	return wrap(func() (TOut, bool) {
 		if it.Next() {
 			value, ok := tryCast[TIn, TOut](it.Current(), target)
 			if !ok {
@@ -469,7 +634,7 @@ func Cast[TIn, TOut any](it collections.Iterator[TIn]) collections.Iterator[TOut
 			return value, true
 		}
 		return utils.Zero[TOut](), false
-	})
+	}, it)
 }
 
 // Expand creates an iterator which iterates all the values from all the iterators
@@ -479,7 +644,7 @@ func Expand[TIn, TOut any, TEnum collections.Iterable[TOut]](
 	expander collections.Selector[TIn, TEnum],
 ) collections.Iterator[TOut] {
 	var current collections.Iterator[TOut]
-	return New(func() (TOut, bool) {
+	return NewClosable(func() (TOut, bool) {
 		for {
 			if current != nil {
 				if current.Next() {
@@ -496,6 +661,8 @@ func Expand[TIn, TOut any, TEnum collections.Iterable[TOut]](
 			}
 			return utils.Zero[TOut](), false
 		}
+	}, func() error {
+		return errors.Join(closeSource(current), closeSource(it))
 	})
 }
 
@@ -504,6 +671,7 @@ func Expand[TIn, TOut any, TEnum collections.Iterable[TOut]](
 // The first call is given the initial value.
 // The last returned value from reduce is returned. or init if no values.
 func Reduce[TIn, TOut any](it collections.Iterator[TIn], init TOut, reducer collections.Reducer[TIn, TOut]) TOut {
+	defer release(it)
 	prior := init
 	for it.Next() {
 		prior = reducer(it.Current(), prior)
@@ -517,6 +685,7 @@ func Reduce[TIn, TOut any](it collections.Iterator[TIn], init TOut, reducer coll
 // The last returned value from merge is returned, the first value if there
 // is only one value, or the zero value if no values.
 func Merge[T any](it collections.Iterator[T], merger collections.Reducer[T, T]) T {
+	defer release(it)
 	prior := utils.Zero[T]()
 	first := true
 	for it.Next() {
@@ -554,7 +723,7 @@ func SlidingWindow[TIn, TOut any](
 
 	frame := make([]TIn, size)
 	loadIndex := 0
-	return New(func() (TOut, bool) {
+	return wrap(func() (TOut, bool) {
 		for loadIndex < size {
 			if !it.Next() {
 				return utils.Zero[TOut](), false
@@ -569,7 +738,7 @@ func SlidingWindow[TIn, TOut any](
 		}
 		loadIndex -= stride
 		return result, true
-	})
+	}, it)
 }
 
 // Chunk creates an iterator which has the values grouped into chunks of the given size.
@@ -583,7 +752,7 @@ func Chunk[T any](it collections.Iterator[T], size int) collections.Iterator[[]T
 
 	frame := make([]T, size)
 	loadIndex := 0
-	return New(func() ([]T, bool) {
+	return wrap(func() ([]T, bool) {
 		for loadIndex < size {
 			if !it.Next() {
 				if loadIndex > 0 {
@@ -600,12 +769,144 @@ func Chunk[T any](it collections.Iterator[T], size int) collections.Iterator[[]T
 		result := slices.Clone(frame)
 		loadIndex = 0
 		return result, true
+	}, it)
+}
+
+// Scan creates an iterator which performs a running reduction of the values
+// in the given iterator and returns every intermediate reduced value.
+// The reducer is called with the prior returned value from the previous call.
+// The first call is given the initial value, which is not itself returned.
+func Scan[TIn, TOut any](it collections.Iterator[TIn], init TOut, reducer collections.Reducer[TIn, TOut]) collections.Iterator[TOut] {
+	if utils.IsNil(reducer) {
+		panic(terror.NilArg(`reducer`))
+	}
+	prior := init
+	return wrap(func() (TOut, bool) {
+		if it.Next() {
+			prior = reducer(it.Current(), prior)
+			return prior, true
+		}
+		return utils.Zero[TOut](), false
+	}, it)
+}
+
+// Pairwise creates an iterator which returns each pair of adjacent values
+// from the given iterator. Each value, except the first and last,
+// is returned as the second value of one pair and the first value of the next.
+// If there are less than two values, no pairs are returned.
+func Pairwise[T any](it collections.Iterator[T]) collections.Iterator[collections.Tuple2[T, T]] {
+	first := true
+	var prev T
+	return wrap(func() (collections.Tuple2[T, T], bool) {
+		if first {
+			first = false
+			if !it.Next() {
+				return utils.Zero[collections.Tuple2[T, T]](), false
+			}
+			prev = it.Current()
+		}
+		if it.Next() {
+			cur := it.Current()
+			pair := tuple2.New(prev, cur)
+			prev = cur
+			return pair, true
+		}
+		return utils.Zero[collections.Tuple2[T, T]](), false
+	}, it)
+}
+
+// SplitWhen creates an iterator which groups consecutive values from the given
+// iterator into slices. A new group is started with each value that satisfies
+// the given predicate, except for the very first value which always starts a group.
+// This is useful for grouping lines of a log where a record begins with a header line.
+func SplitWhen[T any](it collections.Iterator[T], p collections.Predicate[T]) collections.Iterator[[]T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`predicate`))
+	}
+	return groupRuns(it, func(_, cur T) bool {
+		return p(cur)
 	})
 }
 
+// ChunkBy creates an iterator which groups consecutive values from the given
+// iterator into slices. Each group is a run of consecutive values which
+// all have the same key selected from them.
+// Values with the same key which are not consecutive are put into different groups.
+func ChunkBy[T any, K comparable](it collections.Iterator[T], keySelector collections.Selector[T, K]) collections.Iterator[[]T] {
+	if utils.IsNil(keySelector) {
+		panic(terror.NilArg(`keySelector`))
+	}
+	var key K
+	keyed := false
+	return groupRuns(it, func(prev, cur T) bool {
+		if !keyed {
+			key, keyed = keySelector(prev), true
+		}
+		next := keySelector(cur)
+		changed := next != key
+		key = next
+		return changed
+	})
+}
+
+// groupRuns groups consecutive values into slices. A new group is started
+// before the current value when split returns true for the prior and current values.
+func groupRuns[T any](it collections.Iterator[T], split func(prev, cur T) bool) collections.Iterator[[]T] {
+	var group []T
+	first := true
+	return wrap(func() ([]T, bool) {
+		if first {
+			first = false
+			if !it.Next() {
+				return utils.Zero[[]T](), false
+			}
+			group = []T{it.Current()}
+		}
+		if group == nil {
+			return utils.Zero[[]T](), false
+		}
+		for it.Next() {
+			cur := it.Current()
+			if split(group[len(group)-1], cur) {
+				result := group
+				group = []T{cur}
+				return result, true
+			}
+			group = append(group, cur)
+		}
+		result := group
+		group = nil
+		return result, true
+	}, it)
+}
+
+// Intersperse creates an iterator which returns the values from the given
+// iterator with the given separator value between each pair of adjacent values.
+func Intersperse[T any](it collections.Iterator[T], separator T) collections.Iterator[T] {
+	started := false
+	pending := false
+	var next T
+	return wrap(func() (T, bool) {
+		if pending {
+			pending = false
+			return next, true
+		}
+		if !it.Next() {
+			return utils.Zero[T](), false
+		}
+		if !started {
+			started = true
+			return it.Current(), true
+		}
+		next, pending = it.Current(), true
+		return separator, true
+	}, it)
+}
+
 // Sum gets the sum of all value in the given iterator
 // and the number of values that were summed.
 func Sum[T utils.NumConstraint](it collections.Iterator[T]) (T, int) {
+	defer release(it)
 	var sum T
 	count := 0
 	for it.Next() {
@@ -634,7 +935,7 @@ func Unique[T comparable](it collections.Iterator[T]) collections.Iterator[T] {
 // determines the order and if there are repeats in the result.
 func Intersection[T comparable](left, right collections.Iterator[T]) collections.Iterator[T] {
 	inLeft := simpleSet.New[T]()
-	return Where(right, func(value T) bool {
+	w := Where(right, func(value T) bool {
 		if inLeft.Has(value) {
 			return true
 		}
@@ -652,6 +953,7 @@ func Intersection[T comparable](left, right collections.Iterator[T]) collections
 
 		return false
 	})
+	return wrap(fetchableOf(w).fetch, w, left)
 }
 
 // Subtract creates an iterator that returns only the values which exists
@@ -662,7 +964,7 @@ func Intersection[T comparable](left, right collections.Iterator[T]) collections
 // determines the order and if there are repeats in the result.
 func Subtract[T comparable](left, right collections.Iterator[T]) collections.Iterator[T] {
 	inLeft := simpleSet.New[T]()
-	return Where(right, func(value T) bool {
+	w := Where(right, func(value T) bool {
 		if inLeft.Has(value) {
 			return false
 		}
@@ -680,6 +982,143 @@ func Subtract[T comparable](left, right collections.Iterator[T]) collections.Ite
 
 		return true
 	})
+	return wrap(fetchableOf(w).fetch, w, left)
+}
+
+// DistinctBy creates an iterator that returns only the values with unique keys.
+// The key for each value is determined with the given key selector and
+// the first value with each key is returned.
+func DistinctBy[T any, K comparable](it collections.Iterator[T], keySelector collections.Selector[T, K]) collections.Iterator[T] {
+	touched := simpleSet.New[K]()
+	return Where(it, func(value T) bool {
+		return touched.SetTest(keySelector(value))
+	})
+}
+
+// DistinctFunc creates an iterator that returns only the unique values.
+// Uniqueness is determined with the given hasher
+// so that the values do not need to be comparable.
+func DistinctFunc[T any](it collections.Iterator[T], hasher comp.Hasher[T]) collections.Iterator[T] {
+	if utils.IsNil(hasher) {
+		panic(terror.NilArg(`hasher`))
+	}
+	touched := openTable.New[T, struct{}](hasher, 0)
+	return Where(it, func(value T) bool {
+		return touched.Add(value, struct{}{})
+	})
+}
+
+// IntersectBy creates an iterator that returns only the values from the first iterator
+// which have a key that matches the key of any value in the other iterator.
+// The key for each value is determined with the given key selector.
+//
+// The first iterator takes precedence over the result such that it
+// determines the order and if there are repeats in the result.
+// The other iterator is only read as far as needed.
+func IntersectBy[T any, K comparable](it, other collections.Iterator[T], keySelector collections.Selector[T, K]) collections.Iterator[T] {
+	return whereInOther(it, other, keySelector, simpleSet.New[K](), comparableEqual[K], true)
+}
+
+// IntersectFunc creates an iterator that returns only the values from the first
+// iterator which are equal to any value in the other iterator.
+// Equality is determined with the given hasher
+// so that the values do not need to be comparable.
+//
+// The first iterator takes precedence over the result such that it
+// determines the order and if there are repeats in the result.
+// The other iterator is only read as far as needed.
+func IntersectFunc[T any](it, other collections.Iterator[T], hasher comp.Hasher[T]) collections.Iterator[T] {
+	if utils.IsNil(hasher) {
+		panic(terror.NilArg(`hasher`))
+	}
+	return whereInOther(it, other, identity[T], newHashedSet(hasher), hasher.Equal, true)
+}
+
+// ExceptBy creates an iterator that returns only the values from the first iterator
+// which have a key that does not match the key of any value in the other iterator.
+// The key for each value is determined with the given key selector.
+//
+// The first iterator takes precedence over the result such that it
+// determines the order and if there are repeats in the result.
+// The other iterator is only read as far as needed.
+func ExceptBy[T any, K comparable](it, other collections.Iterator[T], keySelector collections.Selector[T, K]) collections.Iterator[T] {
+	return whereInOther(it, other, keySelector, simpleSet.New[K](), comparableEqual[K], false)
+}
+
+// ExceptFunc creates an iterator that returns only the values from the first
+// iterator which are not equal to any value in the other iterator.
+// Equality is determined with the given hasher
+// so that the values do not need to be comparable.
+//
+// The first iterator takes precedence over the result such that it
+// determines the order and if there are repeats in the result.
+// The other iterator is only read as far as needed.
+func ExceptFunc[T any](it, other collections.Iterator[T], hasher comp.Hasher[T]) collections.Iterator[T] {
+	if utils.IsNil(hasher) {
+		panic(terror.NilArg(`hasher`))
+	}
+	return whereInOther(it, other, identity[T], newHashedSet(hasher), hasher.Equal, false)
+}
+
+// keySet is the set used to keep the keys read from the other iterator.
+type keySet[K any] interface {
+	Has(key K) bool
+	Set(key K)
+}
+
+// hashedSet is a key set for values which are not comparable.
+type hashedSet[K any] struct {
+	t *openTable.Table[K, struct{}]
+}
+
+func newHashedSet[K any](hasher comp.Hasher[K]) hashedSet[K] {
+	return hashedSet[K]{t: openTable.New[K, struct{}](hasher, 0)}
+}
+
+func (s hashedSet[K]) Has(key K) bool {
+	return s.t.Has(key)
+}
+
+func (s hashedSet[K]) Set(key K) {
+	s.t.Add(key, struct{}{})
+}
+
+// whereInOther filters the given iterator by if the key of each value is found
+// in the keys of the values in the other iterator. The other iterator is only
+// read until a matching key is found, the keys read are kept in the given set.
+// If found is true then the values with matching keys are returned,
+// otherwise the values without matching keys are returned.
+func whereInOther[T, K any](it, other collections.Iterator[T], keySelector collections.Selector[T, K],
+	inOther keySet[K], equal func(x, y K) bool, found bool,
+) collections.Iterator[T] {
+	w := Where(it, func(value T) bool {
+		key := keySelector(value)
+		if inOther.Has(key) {
+			return found
+		}
+
+		if other != nil {
+			for other.Next() {
+				cur := keySelector(other.Current())
+				inOther.Set(cur)
+				if equal(key, cur) {
+					return found
+				}
+			}
+			other = nil
+		}
+
+		return !found
+	})
+	return wrap(fetchableOf(w).fetch, w, other)
+}
+
+func identity[T any](value T) T {
+	return value
+}
+
+func comparableEqual[T comparable](x, y T) bool {
+	return x == y
 }
 
 // Zip merges two iterators together while both iterators have values
@@ -690,13 +1129,171 @@ func Zip[TFirst, TSecond, TOut any](
 	combiner collections.Combiner[TFirst, TSecond, TOut],
 ) collections.Iterator[TOut] {
 	zipping := true
-	return New(func() (TOut, bool) {
+	return wrap(func() (TOut, bool) {
 		if zipping && firsts.Next() && seconds.Next() {
 			return combiner(firsts.Current(), seconds.Current()), true
 		}
 		zipping = false
 		return utils.Zero[TOut](), false
-	})
+	}, firsts, seconds)
+}
+
+// Join creates an iterator which performs an inner join of the two given iterators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are not returned.
+//
+// All the inner values are read into a lookup the first time a value is read
+// from the returned iterator. The outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func Join[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Iterator[TOuter],
+	inner collections.Iterator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Iterator[TOut] {
+	return join(outer, inner, outerKey, innerKey, combiner, false, false)
+}
+
+// LeftJoin creates an iterator which performs a left outer join of the two given iterators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are combined with the zero inner value.
+//
+// All the inner values are read into a lookup the first time a value is read
+// from the returned iterator. The outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func LeftJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Iterator[TOuter],
+	inner collections.Iterator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Iterator[TOut] {
+	return join(outer, inner, outerKey, innerKey, combiner, true, false)
+}
+
+// FullOuterJoin creates an iterator which performs a full outer join of the two given iterators.
+// Each outer value is combined with every inner value which has a matching key.
+// Outer values without any matching inner values are combined with the zero inner value.
+// After all the outer values, the inner values which did not match any outer value
+// are combined with the zero outer value.
+//
+// All the inner values are read into a lookup the first time a value is read
+// from the returned iterator. The outer values are read only as needed.
+// The results are in the order of the outer values then the order of the inner values.
+func FullOuterJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Iterator[TOuter],
+	inner collections.Iterator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+) collections.Iterator[TOut] {
+	return join(outer, inner, outerKey, innerKey, combiner, true, true)
+}
+
+// GroupJoin creates an iterator which combines each outer value with all of the
+// inner values which have a matching key. Outer values without any matching
+// inner values are combined with an empty slice.
+// Each slice of inner values is a copy so it may be kept or modified.
+//
+// All the inner values are read into a lookup the first time a value is read
+// from the returned iterator. The outer values are read only as needed.
+// The results are in the order of the outer values.
+func GroupJoin[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Iterator[TOuter],
+	inner collections.Iterator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, []TInner, TOut],
+) collections.Iterator[TOut] {
+	var lookup map[TKey][]TInner
+	return wrap(func() (TOut, bool) {
+		if lookup == nil {
+			lookup = map[TKey][]TInner{}
+			for inner.Next() {
+				value := inner.Current()
+				key := innerKey(value)
+				lookup[key] = append(lookup[key], value)
+			}
+		}
+		if outer.Next() {
+			value := outer.Current()
+			return combiner(value, slices.Clone(lookup[outerKey(value)])), true
+		}
+		return utils.Zero[TOut](), false
+	}, outer, inner)
+}
+
+// join performs a join between the two iterators.
+// If leftOuter is true, outer values without matches are combined with the zero inner value.
+// If rightOuter is true, inner values without matches are combined with the zero outer value.
+func join[TOuter, TInner any, TKey comparable, TOut any](
+	outer collections.Iterator[TOuter],
+	inner collections.Iterator[TInner],
+	outerKey collections.Selector[TOuter, TKey],
+	innerKey collections.Selector[TInner, TKey],
+	combiner collections.Combiner[TOuter, TInner, TOut],
+	leftOuter, rightOuter bool,
+) collections.Iterator[TOut] {
+	var lookup map[TKey][]TInner
+	var innerKeys []TKey
+	var innerValues []TInner
+	matched := simpleSet.New[TKey]()
+	outerDone := false
+	remaining := 0
+
+	var current TOuter
+	var matches []TInner
+	return wrap(func() (TOut, bool) {
+		if lookup == nil {
+			lookup = map[TKey][]TInner{}
+			for inner.Next() {
+				value := inner.Current()
+				key := innerKey(value)
+				lookup[key] = append(lookup[key], value)
+				if rightOuter {
+					innerKeys = append(innerKeys, key)
+					innerValues = append(innerValues, value)
+				}
+			}
+		}
+
+		for !outerDone {
+			if len(matches) > 0 {
+				value := matches[0]
+				matches = matches[1:]
+				return combiner(current, value), true
+			}
+
+			if !outer.Next() {
+				outerDone = true
+				current = utils.Zero[TOuter]()
+				break
+			}
+
+			current = outer.Current()
+			key := outerKey(current)
+			if matches = lookup[key]; len(matches) > 0 {
+				if rightOuter {
+					matched.Set(key)
+				}
+				continue
+			}
+
+			if leftOuter {
+				return combiner(current, utils.Zero[TInner]()), true
+			}
+		}
+
+		for count := len(innerValues); remaining < count; {
+			index := remaining
+			remaining++
+			if !matched.Has(innerKeys[index]) {
+				return combiner(utils.Zero[TOuter](), innerValues[index]), true
+			}
+		}
+		return utils.Zero[TOut](), false
+	}, outer, inner)
 }
 
 // Interweave will pull values from each iterator, one at a time,
@@ -704,7 +1301,7 @@ func Zip[TFirst, TSecond, TOut any](
 // When an iterator runs out the remaining will interweave until all are empty.
 func Interweave[T any](its []collections.Iterator[T]) collections.Iterator[T] {
 	index, count := -1, len(its)
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		for i := count - 1; i >= 0; i-- {
 			index++
 			if index >= count {
@@ -718,7 +1315,7 @@ func Interweave[T any](its []collections.Iterator[T]) collections.Iterator[T] {
 			}
 		}
 		return utils.Zero[T](), false
-	})
+	}, sourcesOf(its)...)
 }
 
 // SortInterweave creates an iterator that is the two given iterators interwoven
@@ -730,7 +1327,7 @@ func SortInterweave[T any](left, right collections.Iterator[T], comparer ...comp
 	cmp := optional.Comparer(comparer)
 	hasLeft, hasRight := false, false
 	var leftValue, rightValue T
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if !hasLeft && left.Next() {
 			hasLeft = true
 			leftValue = left.Current()
@@ -756,7 +1353,7 @@ func SortInterweave[T any](left, right collections.Iterator[T], comparer ...comp
 		}
 
 		return utils.Zero[T](), false
-	})
+	}, left, right)
 }
 
 // Sort iterates the values from the given iterator in sorted order.
@@ -768,7 +1365,7 @@ func Sort[T any](it collections.Iterator[T], comparer ...comp.Comparer[T]) colle
 	var index, count int
 	var sortedValues []T
 	first := true
-	return New(func() (T, bool) {
+	return wrap(func() (T, bool) {
 		if first {
 			first = false
 			sortedValues = ToSlice(it)
@@ -783,7 +1380,7 @@ func Sort[T any](it collections.Iterator[T], comparer ...comp.Comparer[T]) colle
 			return sortedValues[index], true
 		}
 		return utils.Zero[T](), false
-	})
+	}, it)
 }
 
 // Sorted returns true if the given values in the iterator are sorted
@@ -791,6 +1388,7 @@ func Sort[T any](it collections.Iterator[T], comparer ...comp.Comparer[T]) colle
 // This can take an optional comparer to override the default or
 // if this type doesn't have a default comparer.
 func Sorted[T any](it collections.Iterator[T], comparer ...comp.Comparer[T]) bool {
+	defer release(it)
 	cmp := optional.Comparer(comparer)
 	if it.Next() {
 		prev := it.Current()
@@ -809,17 +1407,21 @@ func Sorted[T any](it collections.Iterator[T], comparer ...comp.Comparer[T]) boo
 // from the given iterator and an index of the value starting with zero.
 func Indexed[T any](it collections.Iterator[T]) collections.Iterator[collections.Tuple2[int, T]] {
 	index := -1
-	return New(func() (collections.Tuple2[int, T], bool) {
+	return wrap(func() (collections.Tuple2[int, T], bool) {
 		if it.Next() {
 			index++
 			return tuple2.New(index, it.Current()), true
 		}
 		return utils.Zero[collections.Tuple2[int, T]](), false
-	})
+	}, it)
 }
 
+// Seq gets a sequence function for ranging over the values in the given iterator.
+// The iterator is closed, if it can be closed, once the range loop is done,
+// even when the loop breaks before all the values have been read.
 func Seq[T any](it collections.Iterator[T]) iter.Seq[T] {
 	return func(yield func(T) bool) {
+		defer release(it)
 		for it.Next() {
 			if !yield(it.Current()) {
 				return
@@ -828,8 +1430,12 @@ func Seq[T any](it collections.Iterator[T]) iter.Seq[T] {
 	}
 }
 
+// Seq2 gets a sequence function for ranging over the values of the tuples
+// in the given iterator. The iterator is closed, if it can be closed,
+// once the range loop is done, even when the loop breaks early.
 func Seq2[T1, T2 any, T collections.Tuple2[T1, T2]](it collections.Iterator[T]) iter.Seq2[T1, T2] {
 	return func(yield func(T1, T2) bool) {
+		defer release(it)
 		for it.Next() {
 			if !yield(it.Current().Values()) {
 				return
@@ -1,8 +1,12 @@
 package iterator
 
 import (
+	"context"
 	"fmt"
+	"runtime"
+	"slices"
 	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
@@ -93,9 +97,18 @@ func Test_Iterator_WhereNot(t *testing.T) {
 func Test_Iterator_ToSlice(t *testing.T) {
 	it := Iterate(1, 1, 2, 3, 5, 8)
 	checkEqual(t, []int{1, 1, 2, 3, 5, 8}, ToSlice(it))
+	checkZero(t, it.Current())
 
 	it = Iterate[int]()
 	checkEqual(t, []int{}, ToSlice(it))
+
+	it = Take(Iterate(1, 2, 3), 5)
+	checkEqual(t, []int{1, 2, 3}, ToSlice(it))
+	checkZero(t, it.Current())
+
+	it = Skip(Iterate(1, 2, 3), 1)
+	checkEqual(t, []int{2, 3}, ToSlice(it))
+	checkZero(t, it.Current())
 }
 
 func Test_Iterator_CopyToSlice(t *testing.T) {
@@ -270,6 +283,7 @@ func Test_Iterator_Empty(t *testing.T) {
 func Test_Iterator_Count(t *testing.T) {
 	it := Iterate(1, 2, 3, 4)
 	checkEqual(t, 4, Count(it))
+	checkZero(t, it.Current())
 
 	it = Iterate[int]()
 	checkZero(t, Count(it))
@@ -632,6 +646,339 @@ func Test_Iterator_Subtract(t *testing.T) {
 	checkIt(t, it3, 3, 5) // order and duplicates from it2
 }
 
+func Test_Iterator_DistinctBy(t *testing.T) {
+	it := Iterate(`apple`, `ant`, `bat`, `cat`, `banana`, `corn`, `dog`)
+	checkIt(t, DistinctBy(it, func(s string) byte { return s[0] }), `apple`, `bat`, `cat`, `dog`)
+}
+
+func Test_Iterator_DistinctFunc(t *testing.T) {
+	it := Iterate([]int{1, 2}, []int{3}, []int{1, 2}, []int{2, 1}, []int{3}, []int{})
+	checkIt(t, DistinctFunc(it, comp.NewHasher(sliceHash, slices.Equal[[]int])), []int{1, 2}, []int{3}, []int{2, 1}, []int{})
+
+	checkPanic(t, `argument may not be nil {name: hasher}`, func() {
+		DistinctFunc[[]int](it, nil)
+	})
+}
+
+func Test_Iterator_IntersectBy(t *testing.T) {
+	count1, count2 := 0, 0
+	it1 := watcher(&count1, Iterate(`bat`, `ape`, `cow`, `boar`, `ant`, `eel`, `bee`))
+	it2 := watcher(&count2, Iterate(`bear`, `elk`, `crab`, `dog`))
+	it3 := IntersectBy(it1, it2, func(s string) byte { return s[0] })
+
+	checkZero(t, count1)
+	checkZero(t, count2)
+	checkEqual(t, true, it3.Next())
+	checkEqual(t, `bat`, it3.Current())
+	checkEqual(t, 1, count1)
+	checkEqual(t, 1, count2) // iterator 2 only read as much as needed
+
+	checkIt(t, it3, `cow`, `boar`, `eel`, `bee`) // order and duplicates from it1
+}
+
+func Test_Iterator_IntersectFunc(t *testing.T) {
+	it1 := Iterate([]int{1, 2}, []int{3}, []int{4}, []int{1, 2}, []int{})
+	it2 := Iterate([]int{}, []int{3}, []int{1, 2})
+	checkIt(t, IntersectFunc(it1, it2, comp.NewHasher(sliceHash, slices.Equal[[]int])), []int{1, 2}, []int{3}, []int{1, 2}, []int{})
+
+	checkPanic(t, `argument may not be nil {name: hasher}`, func() {
+		IntersectFunc[[]int](it1, it2, nil)
+	})
+}
+
+func Test_Iterator_ExceptBy(t *testing.T) {
+	count1, count2 := 0, 0
+	it1 := watcher(&count1, Iterate(`bat`, `ape`, `cow`, `boar`, `ant`, `eel`, `bee`))
+	it2 := watcher(&count2, Iterate(`bear`, `elk`, `crab`, `dog`))
+	it3 := ExceptBy(it1, it2, func(s string) byte { return s[0] })
+
+	checkZero(t, count1)
+	checkZero(t, count2)
+	checkEqual(t, true, it3.Next())
+	checkEqual(t, `ape`, it3.Current())
+	checkEqual(t, 2, count1)
+	checkEqual(t, 5, count2) // iterator 2 reads whole thing trying to find an `a`
+
+	checkIt(t, it3, `ant`) // order and duplicates from it1
+}
+
+func Test_Iterator_ExceptFunc(t *testing.T) {
+	it1 := Iterate([]int{1, 2}, []int{3}, []int{4}, []int{1, 2}, []int{}, []int{4})
+	it2 := Iterate([]int{}, []int{3}, []int{1, 2})
+	checkIt(t, ExceptFunc(it1, it2, comp.NewHasher(sliceHash, slices.Equal[[]int])), []int{4}, []int{4})
+
+	checkPanic(t, `argument may not be nil {name: hasher}`, func() {
+		ExceptFunc[[]int](it1, it2, nil)
+	})
+}
+
+func sliceHash(s []int) uint64 {
+	hash := uint64(len(s))
+	for _, v := range s {
+		hash = hash*31 + uint64(v)
+	}
+	return hash
+}
+
+func Test_Iterator_Join(t *testing.T) {
+	count1, count2 := 0, 0
+	it1 := watcher(&count1, Iterate(1, 2, 3, 4))
+	it2 := watcher(&count2, Iterate(`one`, `three`, `two`, `ten`, `six`))
+	it3 := Join(it1, it2,
+		func(i int) int { return i },
+		func(s string) int { return len(s) },
+		func(i int, s string) string { return fmt.Sprint(i, s) })
+
+	checkZero(t, count1)
+	checkZero(t, count2)
+	checkEqual(t, true, it3.Next())
+	checkEqual(t, `3one`, it3.Current())
+	checkEqual(t, 3, count1) // outer iterator only read as much as needed
+	checkEqual(t, 6, count2) // inner iterator read fully into the lookup
+
+	checkIt(t, it3, `3two`, `3ten`, `3six`)
+}
+
+func Test_Iterator_LeftJoin(t *testing.T) {
+	it1 := Iterate(1, 3, 5, 3)
+	it2 := Iterate(`one`, `three`, `two`, `ten`)
+	it3 := LeftJoin(it1, it2,
+		func(i int) int { return i },
+		func(s string) int { return len(s) },
+		func(i int, s string) string { return fmt.Sprint(i, `:`, s) })
+	checkIt(t, it3, `1:`, `3:one`, `3:two`, `3:ten`, `5:three`, `3:one`, `3:two`, `3:ten`)
+}
+
+func Test_Iterator_FullOuterJoin(t *testing.T) {
+	it1 := Iterate(1, 3, 6)
+	it2 := Iterate(`one`, `three`, `two`, `four`, `eleven`)
+	it3 := FullOuterJoin(it1, it2,
+		func(i int) int { return i },
+		func(s string) int { return len(s) },
+		func(i int, s string) string { return fmt.Sprint(i, `:`, s) })
+	checkIt(t, it3, `1:`, `3:one`, `3:two`, `6:eleven`, `0:three`, `0:four`)
+}
+
+func Test_Iterator_GroupJoin(t *testing.T) {
+	it1 := Iterate(3, 1, 4)
+	it2 := Iterate(`one`, `three`, `two`, `four`, `ten`)
+	it3 := GroupJoin(it1, it2,
+		func(i int) int { return i },
+		func(s string) int { return len(s) },
+		func(i int, s []string) string { return fmt.Sprintf(`%d%v`, i, s) })
+	checkIt(t, it3, `3[one two ten]`, `1[]`, `4[four]`)
+}
+
+func Test_Iterator_FromChan(t *testing.T) {
+	ch := make(chan int, 5)
+	ch <- 1
+	ch <- 2
+	ch <- 3
+	close(ch)
+	checkIt(t, FromChan(ch), 1, 2, 3)
+	checkIt(t, FromChan(ch)) // channel was already read
+}
+
+func Test_Iterator_FromChanCtx(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan int, 5)
+	ch <- 1
+	ch <- 2
+	it := FromChanCtx(ctx, ch)
+	checkEqual(t, true, it.Next())
+	checkEqual(t, 1, it.Current())
+	cancel()
+	checkEqual(t, false, it.Next()) // stops even though channel isn't closed
+	checkZero(t, it.Current())
+}
+
+func Test_Iterator_ToChan(t *testing.T) {
+	ch, wait := ToChan(context.Background(), Range(1, 5), 2)
+	checkIt(t, FromChan(ch), 1, 2, 3, 4, 5)
+	wait()
+
+	count := 0
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, wait = ToChan(ctx, watcher(&count, Range(1, 1000)), 0)
+	checkEqual(t, 1, <-ch)
+	checkEqual(t, 2, <-ch)
+	cancel()
+	for range ch {
+		// Drain until the producer closes the channel.
+	}
+	wait()
+	checkEqual(t, true, count < 1000)
+
+	ch, wait = ToChan(context.Background(), panicsAfter(2), 0)
+	checkIt(t, FromChan(ch), 1, 2)
+	checkPanic(t, "producer failed: recovered panic {recovered: oops}", wait)
+}
+
+func Test_Iterator_Async(t *testing.T) {
+	count := 0
+	it := Async(watcher(&count, Range(1, 5)), 2)
+	checkZero(t, count) // not started until first read
+	checkIt(t, it, 1, 2, 3, 4, 5)
+	checkEqual(t, false, it.Next())
+	checkEqual(t, nil, it.Close())
+
+	closed := make(chan struct{})
+	source := New(func() (int, bool) {
+		select {
+		case <-closed:
+			return 0, false
+		default:
+			return 7, true
+		}
+	})
+	it = Async(source, 1)
+	checkEqual(t, true, it.Next())
+	checkEqual(t, 7, it.Current())
+	checkEqual(t, nil, it.Close())
+	checkEqual(t, false, it.Next())
+	checkZero(t, it.Current())
+	close(closed)
+	checkEqual(t, nil, it.Close()) // closing twice has no effect
+
+	it = Async(panicsAfter(2), 1)
+	checkEqual(t, true, it.Next())
+	checkEqual(t, 1, it.Current())
+	checkEqual(t, true, it.Next())
+	checkEqual(t, 2, it.Current())
+	checkPanic(t, "producer failed: recovered panic {recovered: oops}", func() { it.Next() })
+	checkEqual(t, false, it.Next())
+}
+
+// panicsAfter creates an iterator which returns the values one
+// through the given count and then panics when read again.
+func panicsAfter(count int) collections.Iterator[int] {
+	value := 0
+	return New(func() (int, bool) {
+		if value >= count {
+			panic(`oops`)
+		}
+		value++
+		return value, true
+	})
+}
+
+func Test_Iterator_NewClosable(t *testing.T) {
+	closed := 0
+	it := NewClosable(fetcherOf(Range(1, 3)), func() error {
+		closed++
+		return nil
+	})
+	checkEqual(t, true, it.Next())
+	checkEqual(t, nil, it.Close())
+	checkEqual(t, 1, closed)
+	checkEqual(t, false, it.Next())
+	checkZero(t, it.Current())
+	checkEqual(t, nil, it.Close()) // closing twice has no effect
+	checkEqual(t, 1, closed)
+
+	closed = 0
+	it = NewClosable(fetcherOf(Range(1, 3)), func() error {
+		closed++
+		return nil
+	})
+	checkEqual(t, 3, Count(it))
+	checkEqual(t, 1, closed) // closed when finished
+
+	it = NewClosable(fetcherOf(Range(1, 3)), func() error {
+		return terror.New(`oops`)
+	})
+	checkEqual(t, `oops`, it.Close().Error())
+	checkEqual(t, nil, it.Close())
+
+	it = NewClosable[int](nil, nil)
+	checkEqual(t, false, it.Next())
+	checkEqual(t, nil, it.Close())
+}
+
+func Test_Iterator_Close(t *testing.T) {
+	closed := atomic.Int32{}
+	source := func() collections.Iterator[int] {
+		return NewClosable(fetcherOf(Range(1, 10)), func() error {
+			closed.Add(1)
+			return nil
+		})
+	}
+	check := func(name string, op func()) {
+		closed.Store(0)
+		op()
+		if count := closed.Load(); count != 1 {
+			t.Errorf(`expected %s to close the source once but was closed %d times`, name, count)
+		}
+	}
+
+	check(`First`, func() { First(source()) })
+	check(`Get`, func() { Get(source(), 3) })
+	check(`Single`, func() { Single(source()) })
+	check(`Empty`, func() { Empty(source()) })
+	check(`Any`, func() { Any(source(), func(v int) bool { return v > 2 }) })
+	check(`All`, func() { All(source(), func(v int) bool { return v < 2 }) })
+	check(`AtLeast`, func() { AtLeast(source(), 2) })
+	check(`AtMost`, func() { AtMost(source(), 2) })
+	check(`StepsUntil`, func() { StepsUntil(source(), func(v int) bool { return v > 2 }) })
+	check(`StartsWith`, func() { StartsWith(source(), Range(1, 2)) })
+	check(`Equal`, func() { Equal(source(), Range(2, 2)) })
+	check(`Sorted`, func() { Sorted(Reverse(source())) })
+	check(`CopyToSlice`, func() { CopyToSlice(source(), make([]int, 2)) })
+	check(`DoUntilError`, func() {
+		DoUntilError(source(), func(int) error { return terror.New(`stop`) })
+	})
+	check(`DoUntilNotZero`, func() {
+		DoUntilNotZero(source(), func(v int) int { return v })
+	})
+	check(`Where`, func() { First(Where(source(), func(v int) bool { return v > 2 })) })
+	check(`Select`, func() { First(Select(source(), func(v int) string { return fmt.Sprint(v) })) })
+	check(`Take`, func() { ToSlice(Take(source(), 2)) })
+	check(`Skip`, func() { First(Skip(source(), 2)) })
+	check(`TakeWhile`, func() { Foreach(TakeWhile(source(), func(v int) bool { return v < 3 }), func(int) {}) })
+	check(`Zip`, func() { Count(Zip(Range(1, 2), source(), func(x, y int) int { return x + y })) })
+	check(`Concat`, func() { First(Concat([]collections.Iterator[int]{Range(1, 2), source()})) })
+	check(`Expand`, func() {
+		First(Expand(Range(1, 2), func(int) collections.Iterable[int] { return source }))
+	})
+	check(`Intersection`, func() { First(Intersection(source(), Range(1, 3))) })
+	check(`Async`, func() {
+		it := Async(source(), 1)
+		it.Next()
+		checkEqual(t, nil, it.Close())
+		for closed.Load() == 0 {
+			// Wait for the producer to stop and close the source.
+			runtime.Gosched()
+		}
+	})
+	check(`Seq`, func() {
+		for v := range Seq(Where(source(), predicate.GreaterThan(2))) {
+			if v > 4 {
+				break
+			}
+		}
+	})
+
+	checkEqual(t, nil, Close(Range(1, 3)))
+	checkEqual(t, nil, Close(Where(Range(1, 3), predicate.GreaterThan(2))))
+}
+
+func Test_Iterator_WithContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	closed := 0
+	it := WithContext(ctx, NewClosable(fetcherOf(Range(1, 10)), func() error {
+		closed++
+		return nil
+	}))
+	checkEqual(t, true, it.Next())
+	checkEqual(t, true, it.Next())
+	checkEqual(t, 2, it.Current())
+	cancel()
+	checkEqual(t, false, it.Next())
+	checkEqual(t, 1, closed)
+
+	checkIt(t, WithContext(context.Background(), Range(1, 3)), 1, 2, 3)
+}
+
 func Test_Iterator_Zip(t *testing.T) {
 	it1 := Iterate(`a`, `b`, `c`, `d`, `ex`, `cat `)
 	it2 := Iterate(1, 3, 1, 3, 1, 2)
@@ -719,6 +1066,15 @@ func Test_Iterator_Seq2(t *testing.T) {
 	checkEqual(t, []string{`0:321`, `1:432`, `2:543`, `3:654`, `4:765`}, values)
 }
 
+func fetcherOf[T any](it collections.Iterator[T]) Fetcher[T] {
+	return func() (T, bool) {
+		if it.Next() {
+			return it.Current(), true
+		}
+		return utils.Zero[T](), false
+	}
+}
+
 func watcher[T any](count *int, it collections.Iterator[T]) collections.Iterator[T] {
 	return New(func() (T, bool) {
 		*count++
@@ -727,6 +1083,161 @@ func watcher[T any](count *int, it collections.Iterator[T]) collections.Iterator
 	})
 }
 
+func Test_Iterator_Scan(t *testing.T) {
+	add := func(value, prior int) int { return value + prior }
+	checkIt(t, Scan(Range(1, 5), 0, add), 1, 3, 6, 10, 15)
+	checkIt(t, Scan(Range(1, 0), 10, add))
+	checkIt(t, Scan(Iterate(`a`, `b`, `c`), `>`, func(value, prior string) string {
+		return prior + value
+	}), `>a`, `>ab`, `>abc`)
+}
+
+func Test_Iterator_Pairwise(t *testing.T) {
+	checkIt(t, Pairwise(Range(1, 4)),
+		tuple2.New(1, 2), tuple2.New(2, 3), tuple2.New(3, 4))
+	checkIt(t, Pairwise(Range(1, 1)))
+	checkIt(t, Pairwise(Range(1, 0)))
+}
+
+func Test_Iterator_SplitWhen(t *testing.T) {
+	isHeader := func(value string) bool { return strings.HasPrefix(value, `#`) }
+	checkIt(t, SplitWhen(Iterate(`#1`, `a`, `b`, `#2`, `#3`, `c`), isHeader),
+		[]string{`#1`, `a`, `b`}, []string{`#2`}, []string{`#3`, `c`})
+	checkIt(t, SplitWhen(Iterate(`a`, `#1`, `b`), isHeader),
+		[]string{`a`}, []string{`#1`, `b`})
+	checkIt(t, SplitWhen(Iterate[string](), isHeader))
+}
+
+func Test_Iterator_ChunkBy(t *testing.T) {
+	calls := 0
+	isEven := func(value int) bool {
+		calls++
+		return value%2 == 0
+	}
+	checkIt(t, ChunkBy(Iterate(1, 3, 2, 4, 6, 5, 8), isEven),
+		[]int{1, 3}, []int{2, 4, 6}, []int{5}, []int{8})
+	checkEqual(t, 7, calls)
+	checkIt(t, ChunkBy(Iterate(1), isEven), []int{1})
+	checkIt(t, ChunkBy(Iterate[int](), isEven))
+}
+
+func Test_Iterator_Intersperse(t *testing.T) {
+	checkIt(t, Intersperse(Iterate(`a`, `b`, `c`), `,`), `a`, `,`, `b`, `,`, `c`)
+	checkIt(t, Intersperse(Iterate(`a`), `,`), `a`)
+	checkIt(t, Intersperse(Iterate[string](), `,`))
+}
+
+func Test_Iterator_Product(t *testing.T) {
+	checkIt(t, Product([]int{1, 2}, []int{3}, []int{4, 5}),
+		[]int{1, 3, 4}, []int{1, 3, 5}, []int{2, 3, 4}, []int{2, 3, 5})
+	checkIt(t, Product([]int{1, 2}, []int{}))
+	checkIt(t, Product[int](), []int{})
+}
+
+func Test_Iterator_Permutations(t *testing.T) {
+	checkIt(t, Permutations([]int{1, 2, 3}, 2),
+		[]int{1, 2}, []int{1, 3}, []int{2, 1}, []int{2, 3}, []int{3, 1}, []int{3, 2})
+	checkIt(t, Permutations([]int{1, 2, 3}, 3),
+		[]int{1, 2, 3}, []int{1, 3, 2}, []int{2, 1, 3}, []int{2, 3, 1}, []int{3, 1, 2}, []int{3, 2, 1})
+	checkIt(t, Permutations([]int{1, 2}, 0), []int{})
+	checkIt(t, Permutations([]int{1, 2}, 3))
+
+	perms := ToSlice(Permutations([]int{0, 1, 2, 3, 4, 5}, 4))
+	checkEqual(t, 360, len(perms))
+	checkEqual(t, true, slices.IsSortedFunc(perms, slices.Compare[[]int]))
+
+	checkPanic(t, `the number of values to select may not be negative {k: -1}`, func() {
+		Permutations([]int{1, 2}, -1)
+	})
+}
+
+func Test_Iterator_Combinations(t *testing.T) {
+	checkIt(t, Combinations([]int{1, 2, 3, 4}, 2),
+		[]int{1, 2}, []int{1, 3}, []int{1, 4}, []int{2, 3}, []int{2, 4}, []int{3, 4})
+	checkIt(t, Combinations([]int{1, 2, 3}, 3), []int{1, 2, 3})
+	checkIt(t, Combinations([]int{1, 2, 3}, 0), []int{})
+	checkIt(t, Combinations([]int{1, 2, 3}, 4))
+
+	combs := ToSlice(Combinations([]int{0, 1, 2, 3, 4, 5, 6, 7}, 3))
+	checkEqual(t, 56, len(combs))
+	checkEqual(t, true, slices.IsSortedFunc(combs, slices.Compare[[]int]))
+}
+
+func Test_Iterator_CombinationsWithReplacement(t *testing.T) {
+	checkIt(t, CombinationsWithReplacement([]int{1, 2, 3}, 2),
+		[]int{1, 1}, []int{1, 2}, []int{1, 3}, []int{2, 2}, []int{2, 3}, []int{3, 3})
+	checkIt(t, CombinationsWithReplacement([]int{1}, 3), []int{1, 1, 1})
+	checkIt(t, CombinationsWithReplacement([]int{}, 0), []int{})
+	checkIt(t, CombinationsWithReplacement([]int{}, 2))
+}
+
+func Test_Iterator_PowerSet(t *testing.T) {
+	checkIt(t, PowerSet([]int{1, 2, 3}),
+		[]int{}, []int{1}, []int{1, 2}, []int{1, 2, 3}, []int{1, 3}, []int{2}, []int{2, 3}, []int{3})
+	checkIt(t, PowerSet([]int{1}), []int{}, []int{1})
+	checkIt(t, PowerSet([]int{}), []int{})
+
+	sets := ToSlice(PowerSet([]int{0, 1, 2, 3, 4, 5, 6}))
+	checkEqual(t, 128, len(sets))
+	checkEqual(t, true, slices.IsSortedFunc(sets, slices.Compare[[]int]))
+}
+
+func Test_Iterator_Get(t *testing.T) {
+	for _, source := range []func() collections.Iterator[int]{
+		func() collections.Iterator[int] { return Iterate(1, 2, 3, 4) },
+		func() collections.Iterator[int] { return Range(1, 4) },
+	} {
+		value, ok := Get(source(), 2)
+		checkEqual(t, 3, value)
+		checkEqual(t, true, ok)
+
+		value, ok = Get(source(), 4)
+		checkEqual(t, 0, value)
+		checkEqual(t, false, ok)
+
+		_, ok = Get(source(), -1)
+		checkEqual(t, false, ok)
+
+		it := source()
+		value, _ = Get(it, 1)
+		checkEqual(t, 2, value)
+		checkIt(t, it, 3, 4)
+	}
+}
+
+func Test_Iterator_FusedStages(t *testing.T) {
+	isOdd := func(value int) bool { return value%2 == 1 }
+	square := func(value int) int { return value * value }
+
+	// Filter and map stages are fused together.
+	it := Select(Where(Iterate(1, 2, 3, 4, 5, 6, 7), isOdd), square)
+	checkIt(t, Take(Where(it, predicate.GreaterThan(1)), 2), 9, 25)
+
+	// Stages on a slice still read from the source so it can be continued.
+	src := Iterate(1, 2, 3, 4, 5, 6)
+	checkIt(t, Take(src, 2), 1, 2)
+	checkEqual(t, 2, Count(Take(src, 2)))
+	checkIt(t, src, 5, 6)
+
+	src = Iterate(1, 2, 3, 4, 5, 6)
+	checkEqual(t, []int{3, 4}, ToSlice(Take(Skip(src, 2), 2)))
+	last, ok := Last(Skip(src, 1))
+	checkEqual(t, 6, last)
+	checkEqual(t, true, ok)
+	checkIt(t, src)
+
+	// Skipping is deferred until the first value is read.
+	src = Iterate(1, 2, 3, 4, 5, 6)
+	skipped := Skip(src, 3)
+	checkEqual(t, true, src.Next())
+	checkEqual(t, 1, src.Current())
+	checkIt(t, skipped, 5, 6)
+
+	checkEqual(t, 4, Count(Skip(Range(1, 6), 2)))
+	checkEqual(t, 0, Count(Skip(Iterate(1, 2), 4)))
+	checkEqual(t, []int{}, ToSlice(Take(Iterate(1, 2), -1)))
+}
+
 func checkIt[T any](t *testing.T, it collections.Iterator[T], exp ...T) {
 	var parts []T
 	for it.Next() {
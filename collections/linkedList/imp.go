@@ -9,6 +9,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/internal/optional"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
@@ -217,9 +218,12 @@ func (list *linkedListImp[T]) String() string {
 }
 
 func (list *linkedListImp[T]) Equals(other any) bool {
-	s, ok := other.(collections.Collection[T])
-	return ok && list.count == s.Count() &&
-		list.Enumerate().Equals(s.Enumerate())
+	s, ok := other.(collections.ReadonlyList[T])
+	return ok && equality.Sequence[T](list, s)
+}
+
+func (list *linkedListImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](list)
 }
 
 func (list *linkedListImp[T]) OnChange() events.Event[collections.ChangeArgs] {
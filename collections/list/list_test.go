@@ -6,7 +6,9 @@ import (
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/linkedList"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/readonlyVariantList"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
 )
@@ -340,3 +342,31 @@ func Test_List_OnChange(t *testing.T) {
 	s.Clear()
 	check.StringAndReset(t, ``).Assert(buf)
 }
+
+func Test_List_EqualsAcrossImplementations(t *testing.T) {
+	l1 := With(1, 2, 3)
+	l2 := linkedList.With(1, 2, 3)
+	values := []int{1, 2, 3}
+	l3 := readonlyVariantList.From(
+		func() int { return len(values) },
+		func(index int) int { return values[index] }, nil)
+
+	check.True(t).Assert(l1.Equals(l2))
+	check.True(t).Assert(l2.Equals(l1))
+	check.True(t).Assert(l1.Equals(l3))
+	check.True(t).Assert(l3.Equals(l2))
+	check.True(t).Assert(l1.Readonly().Equals(l2.Readonly()))
+	check.Equal(t, l1.Hash()).Assert(l2.Hash())
+	check.Equal(t, l1.Hash()).Assert(l3.Hash())
+	check.Equal(t, l1.Hash()).Assert(l2.Readonly().Hash())
+
+	l2.Append(4)
+	check.False(t).Assert(l1.Equals(l2))
+	check.False(t).Assert(l2.Equals(l1))
+	check.NotEqual(t, l1.Hash()).Assert(l2.Hash())
+
+	check.False(t).Assert(With(1, 3, 2).Equals(l1))
+	check.NotEqual(t, With(1, 3, 2).Hash()).Assert(l1.Hash())
+	check.False(t).Assert(l1.Equals(l1.Enumerate()))
+	check.False(t).Assert(l1.Equals(values))
+}
@@ -0,0 +1,24 @@
+package collections
+
+import "github.com/Snow-Gremlin/goToolbox/comp"
+
+// OrderedEnumerator is an enumerator which enumerates the values
+// in a stable sorted order determined by one or more ordering levels.
+//
+// Subsequent ordering levels are only used to order values
+// which are equal for all prior ordering levels.
+type OrderedEnumerator[T any] interface {
+	Enumerator[T]
+
+	// ThenBy adds a subsequent ascending ordering level using the given comparer.
+	//
+	// This can take an optional comparer to override the default comparer
+	// or to give a comparer if there is no default comparer for this type.
+	ThenBy(comparer ...comp.Comparer[T]) OrderedEnumerator[T]
+
+	// ThenByDescending adds a subsequent descending ordering level using the given comparer.
+	//
+	// This can take an optional comparer to override the default comparer
+	// or to give a comparer if there is no default comparer for this type.
+	ThenByDescending(comparer ...comp.Comparer[T]) OrderedEnumerator[T]
+}
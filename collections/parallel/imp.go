@@ -0,0 +1,288 @@
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// bufferFactor is the number of values per worker which may be
+// in progress or waiting to be read at any one time.
+const bufferFactor = 4
+
+// process is the work performed on each value by the workers.
+// It returns the result, true if the result should be kept,
+// and any error which should stop the pipeline.
+type process[TIn, TOut any] func(index int, value TIn) (TOut, bool, error)
+
+type job[T any] struct {
+	index int
+	value T
+}
+
+type result[T any] struct {
+	index int
+	value T
+	keep  bool
+	err   error
+}
+
+// pipeline reads values from a source iterator on one goroutine,
+// processes them on several worker goroutines, and collects the results
+// on the goroutine calling next.
+type pipeline[TIn, TOut any] struct {
+	parent  context.Context
+	ctx     context.Context
+	cancel  context.CancelFunc
+	jobs    chan job[TIn]
+	results chan result[TOut]
+	tokens  chan struct{}
+	ordered bool
+	pending map[int]result[TOut]
+	index   int
+	err     error
+	fedAll  atomic.Bool
+	dropped atomic.Bool
+}
+
+func newPipeline[TIn, TOut any](parent context.Context, it collections.Iterator[TIn],
+	workers int, ordered bool, proc process[TIn, TOut],
+) *pipeline[TIn, TOut] {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	ctx, cancel := context.WithCancel(parent)
+	p := &pipeline[TIn, TOut]{
+		parent:  parent,
+		ctx:     ctx,
+		cancel:  cancel,
+		jobs:    make(chan job[TIn]),
+		results: make(chan result[TOut]),
+		tokens:  make(chan struct{}, workers*bufferFactor),
+		ordered: ordered,
+		pending: map[int]result[TOut]{},
+		index:   0,
+		err:     nil,
+		fedAll:  atomic.Bool{},
+		dropped: atomic.Bool{},
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(workers + 1)
+	go func() {
+		defer wg.Done()
+		p.feed(it)
+	}()
+	for range workers {
+		go func() {
+			defer wg.Done()
+			p.work(proc)
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(p.results)
+	}()
+	return p
+}
+
+// feed reads the values from the source iterator and passes them to the workers.
+// The tokens limit how many values may be in progress at one time.
+func (p *pipeline[TIn, TOut]) feed(it collections.Iterator[TIn]) {
+	defer close(p.jobs)
+	defer func() { _ = iterator.Close(it) }()
+	index := 0
+	defer func() {
+		if r := recover(); r != nil {
+			p.send(result[TOut]{
+				index: index,
+				value: utils.Zero[TOut](),
+				keep:  false,
+				err:   recovered(index, r),
+			})
+		}
+	}()
+
+	for ; ; index++ {
+		select {
+		case p.tokens <- struct{}{}:
+		case <-p.ctx.Done():
+			return
+		}
+		if !it.Next() {
+			p.fedAll.Store(true)
+			return
+		}
+		select {
+		case p.jobs <- job[TIn]{index: index, value: it.Current()}:
+		case <-p.ctx.Done():
+			return
+		}
+	}
+}
+
+// work processes values until there are no more values or the pipeline is canceled.
+func (p *pipeline[TIn, TOut]) work(proc process[TIn, TOut]) {
+	for j := range p.jobs {
+		if !p.send(run(j, proc)) {
+			return
+		}
+	}
+}
+
+// send sends the given result to the collector unless the pipeline is canceled.
+// Returns true if the result was sent.
+func (p *pipeline[TIn, TOut]) send(r result[TOut]) bool {
+	select {
+	case p.results <- r:
+		return true
+	case <-p.ctx.Done():
+		p.dropped.Store(true)
+		return false
+	}
+}
+
+// run processes a single job and captures any panic as an error.
+func run[TIn, TOut any](j job[TIn], proc process[TIn, TOut]) (r result[TOut]) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			r = result[TOut]{
+				index: j.index,
+				value: utils.Zero[TOut](),
+				keep:  false,
+				err:   recovered(j.index, rec),
+			}
+		}
+	}()
+
+	value, keep, err := proc(j.index, j.value)
+	return result[TOut]{
+		index: j.index,
+		value: value,
+		keep:  keep,
+		err:   err,
+	}
+}
+
+// next gets the next kept result from the pipeline.
+// Returns false when there are no more results or an error has occurred.
+func (p *pipeline[TIn, TOut]) next() (TOut, bool) {
+	for p.err == nil {
+		if p.ordered {
+			if r, has := p.pending[p.index]; has {
+				delete(p.pending, p.index)
+				p.index++
+				<-p.tokens
+				if r.keep {
+					return r.value, true
+				}
+				continue
+			}
+		}
+
+		r, ok := <-p.results
+		if !ok {
+			p.finish()
+			break
+		}
+
+		if r.err != nil {
+			p.err = r.err
+			p.cancel()
+			break
+		}
+
+		if p.ordered {
+			p.pending[r.index] = r
+			continue
+		}
+
+		<-p.tokens
+		if r.keep {
+			return r.value, true
+		}
+	}
+	return utils.Zero[TOut](), false
+}
+
+// finish is called once all the workers have stopped
+// to release the context and check if any values were
+// not processed because of a cancellation.
+func (p *pipeline[TIn, TOut]) finish() {
+	p.cancel()
+	p.pending = nil
+	if !p.fedAll.Load() || p.dropped.Load() {
+		if err := p.parent.Err(); err != nil {
+			p.err = canceled(err)
+		}
+	}
+}
+
+// drain reads all the remaining results from the pipeline.
+// Returns the first error which occurred, or nil.
+func (p *pipeline[TIn, TOut]) drain(handle func(value TOut)) error {
+	for {
+		value, ok := p.next()
+		if !ok {
+			return p.err
+		}
+		handle(value)
+	}
+}
+
+// close cancels the pipeline and waits for the feeder and workers to stop.
+func (p *pipeline[TIn, TOut]) close() {
+	p.cancel()
+	for range p.results {
+		// Discard any results which were sent before the cancel.
+	}
+}
+
+// iterate creates an iterator which reads the results from a new pipeline.
+// The pipeline is not started until the first value is read.
+// If the pipeline stops due to an error, the error is panicked.
+//
+// Closing the iterator, which the terminal operators do when they stop
+// reading early, cancels the pipeline and stops the worker goroutines.
+func iterate[TIn, TOut any](ctx context.Context, source collections.Iterable[TIn],
+	workers int, ordered bool, proc process[TIn, TOut],
+) collections.Iterator[TOut] {
+	var p *pipeline[TIn, TOut]
+	return iterator.NewClosable(func() (TOut, bool) {
+		if p == nil {
+			p = newPipeline(ctx, source(), workers, ordered, proc)
+		}
+		value, ok := p.next()
+		if p.err != nil {
+			panic(p.err)
+		}
+		return value, ok
+	}, func() error {
+		if p != nil {
+			p.close()
+		}
+		return nil
+	})
+}
+
+func recovered(index int, r any) terrors.TError {
+	return terror.New(`parallel operation failed`, terror.RecoveredPanic(r)).
+		With(`index`, index)
+}
+
+func failed(index int, err error) terrors.TError {
+	return terror.New(`parallel operation failed`, err).
+		With(`index`, index)
+}
+
+func canceled(err error) terrors.TError {
+	return terror.New(`parallel operation canceled`, err)
+}
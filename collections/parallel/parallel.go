@@ -0,0 +1,211 @@
+package parallel
+
+import (
+	"context"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// reduceChunkSize is the number of values reduced together by one worker.
+const reduceChunkSize = 64
+
+// Select creates an enumerator which converts each value from the given enumerator
+// using the given selector on the given number of worker goroutines.
+// The results are returned in the same order as the values from the given enumerator.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// The given enumerator is read from a single goroutine and the selector
+// is called from several goroutines at once.
+// If the selector panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is panicked when reading the results.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is panicked.
+func Select[TIn, TOut any](e collections.Enumerator[TIn], workers int, selector collections.Selector[TIn, TOut], ctx ...context.Context) collections.Enumerator[TOut] {
+	return selectImp(e, workers, selector, true, ctx)
+}
+
+// SelectUnordered creates an enumerator which converts each value from the given enumerator
+// using the given selector on the given number of worker goroutines.
+// The results are returned in the order that they are finished.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// The given enumerator is read from a single goroutine and the selector
+// is called from several goroutines at once.
+// If the selector panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is panicked when reading the results.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is panicked.
+func SelectUnordered[TIn, TOut any](e collections.Enumerator[TIn], workers int, selector collections.Selector[TIn, TOut], ctx ...context.Context) collections.Enumerator[TOut] {
+	return selectImp(e, workers, selector, false, ctx)
+}
+
+func selectImp[TIn, TOut any](e collections.Enumerator[TIn], workers int, selector collections.Selector[TIn, TOut], ordered bool, ctx []context.Context) collections.Enumerator[TOut] {
+	if utils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	c := optional.Context(ctx)
+	proc := func(_ int, value TIn) (TOut, bool, error) {
+		return selector(value), true, nil
+	}
+	return enumerator.New(func() collections.Iterator[TOut] {
+		return iterate(c, e.Iterate, workers, ordered, proc)
+	})
+}
+
+// Where creates an enumerator which filters the values from the given enumerator
+// to only values which satisfy the given predicate. The predicate is run on the
+// given number of worker goroutines. The results are returned in the same order
+// as the values from the given enumerator.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// The given enumerator is read from a single goroutine and the predicate
+// is called from several goroutines at once.
+// If the predicate panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is panicked when reading the results.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is panicked.
+func Where[T any](e collections.Enumerator[T], workers int, p collections.Predicate[T], ctx ...context.Context) collections.Enumerator[T] {
+	return whereImp(e, workers, p, true, ctx)
+}
+
+// WhereUnordered creates an enumerator which filters the values from the given enumerator
+// to only values which satisfy the given predicate. The predicate is run on the
+// given number of worker goroutines. The results are returned in the order that
+// they are finished.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// The given enumerator is read from a single goroutine and the predicate
+// is called from several goroutines at once.
+// If the predicate panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is panicked when reading the results.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is panicked.
+func WhereUnordered[T any](e collections.Enumerator[T], workers int, p collections.Predicate[T], ctx ...context.Context) collections.Enumerator[T] {
+	return whereImp(e, workers, p, false, ctx)
+}
+
+func whereImp[T any](e collections.Enumerator[T], workers int, p collections.Predicate[T], ordered bool, ctx []context.Context) collections.Enumerator[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`predicate`))
+	}
+	c := optional.Context(ctx)
+	proc := func(_ int, value T) (T, bool, error) {
+		return value, p(value), nil
+	}
+	return enumerator.New(func() collections.Iterator[T] {
+		return iterate(c, e.Iterate, workers, ordered, proc)
+	})
+}
+
+// Foreach runs the given function for each value from the given enumerator
+// on the given number of worker goroutines. The function may be called
+// from several goroutines at once and in any order.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// If the function returns an error or panics, the remaining work is stopped
+// and the first error is returned as a terror with the index of the value
+// which caused the error.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is returned.
+func Foreach[T any](e collections.Enumerator[T], workers int, m func(value T) error, ctx ...context.Context) error {
+	if utils.IsNil(m) {
+		panic(terror.NilArg(`m`))
+	}
+	proc := func(index int, value T) (struct{}, bool, error) {
+		if err := m(value); err != nil {
+			return struct{}{}, false, failed(index, err)
+		}
+		return struct{}{}, false, nil
+	}
+	p := newPipeline(optional.Context(ctx), e.Iterate(), workers, false, proc)
+	return p.drain(func(struct{}) {})
+}
+
+// Reduce performs a reduction of the values in the given enumerator
+// on the given number of worker goroutines.
+//
+// The values are split into contiguous chunks and each chunk is reduced
+// on a worker starting from the given initial value. The results of the chunks
+// are merged in the same order as the values using the given merger,
+// starting from the initial value. The reducer and merger must be associative,
+// and the initial value must not change the result when merged, like zero for a sum.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// If the reducer panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is returned.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is returned.
+func Reduce[TIn, TOut any](e collections.Enumerator[TIn], workers int, init TOut,
+	reducer collections.Reducer[TIn, TOut], merger collections.Reducer[TOut, TOut], ctx ...context.Context,
+) (TOut, error) {
+	return reduceImp(e, workers, init, reducer, merger, true, ctx)
+}
+
+// ReduceUnordered performs a reduction of the values in the given enumerator
+// on the given number of worker goroutines.
+//
+// The values are split into contiguous chunks and each chunk is reduced
+// on a worker starting from the given initial value. The results of the chunks
+// are merged in the order that they are finished using the given merger,
+// starting from the initial value. The reducer and merger must be associative
+// and commutative, and the initial value must not change the result when merged,
+// like zero for a sum.
+//
+// If the number of workers is zero or less, then GOMAXPROCS is used.
+// If the reducer panics, the remaining work is stopped and a terror with the
+// index of the value which caused the panic is returned.
+//
+// This can take an optional context. If the context is canceled then the
+// remaining work is stopped and a terror wrapping the context's error is returned.
+func ReduceUnordered[TIn, TOut any](e collections.Enumerator[TIn], workers int, init TOut,
+	reducer collections.Reducer[TIn, TOut], merger collections.Reducer[TOut, TOut], ctx ...context.Context,
+) (TOut, error) {
+	return reduceImp(e, workers, init, reducer, merger, false, ctx)
+}
+
+func reduceImp[TIn, TOut any](e collections.Enumerator[TIn], workers int, init TOut,
+	reducer collections.Reducer[TIn, TOut], merger collections.Reducer[TOut, TOut], ordered bool, ctx []context.Context,
+) (TOut, error) {
+	if utils.IsNil(reducer) {
+		panic(terror.NilArg(`reducer`))
+	}
+	if utils.IsNil(merger) {
+		panic(terror.NilArg(`merger`))
+	}
+
+	proc := func(index int, chunk []TIn) (result TOut, keep bool, err error) {
+		offset := 0
+		defer func() {
+			if r := recover(); r != nil {
+				err = recovered(index*reduceChunkSize+offset, r)
+			}
+		}()
+
+		prior := init
+		for offset = range chunk {
+			prior = reducer(chunk[offset], prior)
+		}
+		return prior, true, nil
+	}
+
+	chunks := iterator.Chunk(e.Iterate(), reduceChunkSize)
+	p := newPipeline(optional.Context(ctx), chunks, workers, ordered, proc)
+	prior := init
+	if err := p.drain(func(value TOut) {
+		prior = merger(value, prior)
+	}); err != nil {
+		return utils.Zero[TOut](), err
+	}
+	return prior, nil
+}
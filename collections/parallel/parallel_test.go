@@ -0,0 +1,195 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+// jitter sleeps a short varying amount of time to make
+// the workers finish out of order.
+func jitter(value int) {
+	time.Sleep(time.Duration((value*7)%5) * time.Millisecond)
+}
+
+func Test_Parallel_Select(t *testing.T) {
+	e := Select(enumerator.Range(0, 50), 4, func(value int) int {
+		jitter(value)
+		return value * 2
+	})
+	exp := enumerator.Stride(0, 2, 50).ToSlice()
+	check.Equal(t, exp).Assert(e.ToSlice())
+	check.Equal(t, exp).Assert(e.ToSlice()) // may be iterated again
+	check.Length(t, 50).Assert(e)
+
+	e = Select(enumerator.Range(0, 0), 4, func(value int) int { return value })
+	check.Empty(t).Assert(e.ToSlice())
+
+	check.MatchError(t, `^argument may not be nil \{name: selector\}$`).Panic(func() {
+		Select[int, int](enumerator.Range(0, 3), 4, nil)
+	})
+}
+
+func Test_Parallel_SelectUnordered(t *testing.T) {
+	e := SelectUnordered(enumerator.Range(0, 50), 0, func(value int) int {
+		jitter(value)
+		return value * 2
+	})
+	values := e.ToSlice()
+	slices.Sort(values)
+	check.Equal(t, enumerator.Stride(0, 2, 50).ToSlice()).Assert(values)
+}
+
+func Test_Parallel_Where(t *testing.T) {
+	isEven := func(value int) bool {
+		jitter(value)
+		return value%2 == 0
+	}
+	e := Where(enumerator.Range(0, 50), 4, isEven)
+	exp := enumerator.Stride(0, 2, 25).ToSlice()
+	check.Equal(t, exp).Assert(e.ToSlice())
+
+	values := WhereUnordered(enumerator.Range(0, 50), 4, isEven).ToSlice()
+	slices.Sort(values)
+	check.Equal(t, exp).Assert(values)
+
+	check.MatchError(t, `^argument may not be nil \{name: predicate\}$`).Panic(func() {
+		Where(enumerator.Range(0, 3), 4, nil)
+	})
+}
+
+func Test_Parallel_Select_Panic(t *testing.T) {
+	e := Select(enumerator.Range(0, 50), 4, func(value int) int {
+		if value == 23 {
+			panic(errors.New(`bad value`))
+		}
+		return value
+	})
+	check.MatchError(t, `^parallel operation failed \{index: 23\}: recovered panic: bad value$`).Panic(func() {
+		e.ToSlice()
+	})
+
+	e = Select(enumerator.Range(0, 50), 2, func(value int) int { return value }).
+		Where(func(value int) bool {
+			if value == 3 {
+				panic(errors.New(`consumer panic`))
+			}
+			return true
+		})
+	check.MatchError(t, `^consumer panic$`).Panic(func() {
+		e.ToSlice()
+	})
+}
+
+func Test_Parallel_Select_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	e := Select(enumerator.Range(0, 1000), 4, func(value int) int {
+		if value == 10 {
+			cancel()
+		}
+		return value
+	}, ctx)
+	check.MatchError(t, `^parallel operation canceled: context canceled$`).Panic(func() {
+		e.ToSlice()
+	})
+
+	check.MatchError(t, `^invalid number of arguments \{count: 2, maximum: 1, usage: context\}$`).Panic(func() {
+		Select(enumerator.Range(0, 3), 4, func(value int) int { return value }, ctx, ctx)
+	})
+}
+
+func Test_Parallel_Select_EarlyExit(t *testing.T) {
+	before := runtime.NumGoroutine()
+	for range 20 {
+		first, ok := Select(enumerator.Range(0, 1000), 4, func(value int) int {
+			return value + 1
+		}).First()
+		check.True(t).Assert(ok)
+		check.Equal(t, 1).Assert(first)
+	}
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	check.LessEq(t, before).Name(`goroutines after First`).Assert(runtime.NumGoroutine())
+
+	taken := WhereUnordered(enumerator.Range(0, 1000), 4, func(value int) bool {
+		return value%2 == 0
+	}).Take(3).ToSlice()
+	check.Length(t, 3).Assert(taken)
+	for i := 0; i < 100 && runtime.NumGoroutine() > before; i++ {
+		time.Sleep(time.Millisecond)
+	}
+	check.LessEq(t, before).Name(`goroutines after Take`).Assert(runtime.NumGoroutine())
+}
+
+func Test_Parallel_Foreach(t *testing.T) {
+	sum := atomic.Int64{}
+	err := Foreach(enumerator.Range(1, 100), 4, func(value int) error {
+		sum.Add(int64(value))
+		return nil
+	})
+	check.NoError(t).Assert(err)
+	check.Equal(t, int64(5050)).Assert(sum.Load())
+
+	err = Foreach(enumerator.Range(0, 100), 4, func(value int) error {
+		if value == 42 {
+			return errors.New(`bad value`)
+		}
+		return nil
+	})
+	check.MatchError(t, `^parallel operation failed \{index: 42\}: bad value$`).Assert(err)
+	check.ErrorHas[terrors.TError](t).Assert(err)
+
+	err = Foreach(enumerator.Range(0, 100), 4, func(value int) error {
+		if value == 17 {
+			panic(`boom`)
+		}
+		return nil
+	})
+	check.MatchError(t, `^parallel operation failed \{index: 17\}: recovered panic \{recovered: boom\}$`).Assert(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = Foreach(enumerator.Range(0, 100), 4, func(value int) error {
+		return nil
+	}, ctx)
+	check.MatchError(t, `^parallel operation canceled: context canceled$`).Assert(err)
+}
+
+func Test_Parallel_Reduce(t *testing.T) {
+	add := func(value, prior int) int { return value + prior }
+	sum, err := Reduce(enumerator.Range(1, 1000), 4, 0, add, add)
+	check.NoError(t).Assert(err)
+	check.Equal(t, 500500).Assert(sum)
+
+	sum, err = ReduceUnordered(enumerator.Range(1, 1000), 4, 0, add, add)
+	check.NoError(t).Assert(err)
+	check.Equal(t, 500500).Assert(sum)
+
+	// Appending is associative but not commutative so the order must be kept.
+	values, err := Reduce(enumerator.Range(0, 500), 4, []int{},
+		func(value int, prior []int) []int { return append(prior, value) },
+		func(value, prior []int) []int { return append(prior, value...) })
+	check.NoError(t).Assert(err)
+	check.Equal(t, enumerator.Range(0, 500).ToSlice()).Assert(values)
+
+	_, err = Reduce(enumerator.Range(0, 500), 4, 0, func(value, prior int) int {
+		if value == 300 {
+			panic(errors.New(`bad value`))
+		}
+		return value + prior
+	}, add)
+	check.MatchError(t, `^parallel operation failed \{index: 300\}: recovered panic: bad value$`).Assert(err)
+
+	check.MatchError(t, `^argument may not be nil \{name: merger\}$`).Panic(func() {
+		_, _ = Reduce(enumerator.Range(0, 3), 4, 0, add, nil)
+	})
+}
@@ -0,0 +1,548 @@
+package explain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/fieldPath"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Predicate is a predicate which can explain why a value passed or failed.
+//
+// The `Test` method may be used anywhere a `collections.Predicate` is
+// expected, e.g. `enumerator.Where(explain.Eq(3).Test)`.
+type Predicate[T any] interface {
+	// Test returns true if the given value is accepted by this predicate.
+	Test(value T) bool
+
+	// Explain tests the given value and explains why it passed or failed.
+	Explain(value T) Explanation
+}
+
+type predicateImp[T any] struct {
+	test    collections.Predicate[T]
+	explain func(value T) Explanation
+}
+
+func (p predicateImp[T]) Test(value T) bool {
+	return p.test(value)
+}
+
+func (p predicateImp[T]) Explain(value T) Explanation {
+	return p.explain(value)
+}
+
+// New creates an explainable predicate from the given predicate
+// and a function which explains why a value passed or failed.
+func New[T any](test collections.Predicate[T], explain func(value T) Explanation) Predicate[T] {
+	if utils.IsNil(test) {
+		panic(terror.NilArg(`test`))
+	}
+	if utils.IsNil(explain) {
+		panic(terror.NilArg(`explain`))
+	}
+	return predicateImp[T]{test: test, explain: explain}
+}
+
+// From creates an explainable predicate from the given predicate
+// using the given description. The reason only states if the value
+// was accepted or not, since nothing is known about the predicate.
+func From[T any](description string, p collections.Predicate[T]) Predicate[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	return leaf(text(description), p, func(value T, passed bool) string {
+		if passed {
+			return utils.String(value) + ` was accepted`
+		}
+		return utils.String(value) + ` was not accepted`
+	})
+}
+
+// leaf creates an explainable predicate which has no sub-predicates.
+// The description is only gotten when a value is explained.
+func leaf[T any](describe func() string, test collections.Predicate[T], reason func(value T, passed bool) string) Predicate[T] {
+	return predicateImp[T]{
+		test: test,
+		explain: func(value T) Explanation {
+			passed := test(value)
+			return Explanation{
+				Passed:      passed,
+				Description: describe(),
+				Reason:      reason(value, passed),
+			}
+		},
+	}
+}
+
+// is creates a leaf where the reason states that the value is,
+// or is not, the given description of what was expected.
+// If negated then the value is expected not to be what was described.
+func is[T any](describe, what func() string, negated bool, test collections.Predicate[T]) Predicate[T] {
+	return leaf(describe, test, func(value T, passed bool) string {
+		if passed != negated {
+			return utils.String(value) + ` is ` + what()
+		}
+		return utils.String(value) + ` is not ` + what()
+	})
+}
+
+// text gets a description which is already known.
+func text(description string) func() string {
+	return func() string {
+		return description
+	}
+}
+
+// call gets the description for a call to a predicate constructor.
+func call(name string, args ...any) string {
+	return name + `(` + strings.Join(utils.Strings(args), `, `) + `)`
+}
+
+// IsNil is a predicate which returns true if the given value is nil.
+// This will return false if the value is not nil-able.
+func IsNil[T any]() Predicate[T] {
+	return is(text(`IsNil`), text(`nil`), false, func(value T) bool {
+		return utils.IsNil(value)
+	})
+}
+
+// IsNotNil is a predicate which returns true if the given value is not nil.
+// This will return true if the value is not nil-able.
+func IsNotNil[T any]() Predicate[T] {
+	return is(text(`IsNotNil`), text(`nil`), true, func(value T) bool {
+		return !utils.IsNil(value)
+	})
+}
+
+// IsZero is a predicate which returns true if the the value is zero.
+func IsZero[T any]() Predicate[T] {
+	return is(text(`IsZero`), text(`zero`), false, utils.IsZero[T])
+}
+
+// IsNotZero is a predicate which returns true if the the value is not zero.
+func IsNotZero[T any]() Predicate[T] {
+	return is(text(`IsNotZero`), text(`zero`), true, func(value T) bool {
+		return !utils.IsZero(value)
+	})
+}
+
+// IsTrue is a predicate which returns true if the the value is true.
+func IsTrue() Predicate[bool] {
+	return is(text(`IsTrue`), text(`true`), false, func(value bool) bool {
+		return value
+	})
+}
+
+// IsFalse is a predicate which returns true if the the value is false.
+func IsFalse() Predicate[bool] {
+	return is(text(`IsFalse`), text(`false`), false, func(value bool) bool {
+		return !value
+	})
+}
+
+// OfType is a predicate which checks if the given value is the given target type.
+func OfType[Target, T any]() Predicate[T] {
+	target := func() string {
+		return utils.TypeOf[Target]().String()
+	}
+	return leaf(func() string {
+		return `OfType[` + target() + `]`
+	}, func(value T) bool {
+		_, ok := any(value).(Target)
+		return ok
+	}, func(value T, passed bool) string {
+		if passed {
+			return fmt.Sprintf(`%T is %s`, value, target())
+		}
+		return fmt.Sprintf(`%T is not %s`, value, target())
+	})
+}
+
+// Matches is a predicate which checks if a string matches the given
+// regular expression pattern.
+//
+// If the pattern is empty or not a valid regular expression,
+// then this will panic.
+func Matches(pattern string) Predicate[string] {
+	if len(pattern) <= 0 {
+		panic(terror.New(`may not used an empty pattern string`))
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(terror.New(`invalid regular expression pattern`).
+			With(`pattern`, pattern).
+			WithError(err))
+	}
+	return leaf(func() string {
+		return call(`Matches`, pattern)
+	}, re.MatchString, func(value string, passed bool) string {
+		if passed {
+			return strconv.Quote(value) + ` matches`
+		}
+		return strconv.Quote(value) + ` does not match`
+	})
+}
+
+// InMap is a predicate which returns true if the given value exists as a key in the given map.
+// The map is used as a set so the values in the map are not used.
+func InMap[TKey comparable, TValue any, M ~map[TKey]TValue](m M) Predicate[TKey] {
+	return is(text(`InMap`), text(`a key in the map`), false, func(value TKey) bool {
+		_, exists := m[value]
+		return exists
+	})
+}
+
+// In is a predicate which returns true if the given value is in the
+// set of values given to create the predicate.
+//
+// This will create a map for fast lookup.
+func In[T comparable](values ...T) Predicate[T] {
+	set := simpleSet.With(values...)
+	describe := func() string {
+		return `In(` + strings.Join(utils.Strings(values), `, `) + `)`
+	}
+	return is(describe, text(`one of the values`), false, func(value T) bool {
+		_, exists := set[value]
+		return exists
+	})
+}
+
+// AsString is a predicate which calls the given predicate with
+// the string of the given value.
+func AsString(p Predicate[string]) Predicate[any] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	return predicateImp[any]{
+		test: func(value any) bool {
+			return p.Test(utils.String(value))
+		},
+		explain: func(value any) Explanation {
+			str := utils.String(value)
+			cause := p.Explain(str)
+			return Explanation{
+				Passed:      cause.Passed,
+				Description: `AsString`,
+				Reason:      `the string is ` + strconv.Quote(str),
+				Causes:      []Explanation{cause},
+			}
+		},
+	}
+}
+
+// Eq is a predicate which returns true if the given value
+// is equal to the value passed into the predicate.
+func Eq[T any](value T) Predicate[T] {
+	return is(func() string {
+		return call(`Eq`, value)
+	}, func() string {
+		return `equal to ` + utils.String(value)
+	}, false, func(query T) bool {
+		return comp.Equal(query, value)
+	})
+}
+
+// NotEq is a predicate which returns true if the given value
+// is not equal to the value passed into the predicate.
+func NotEq[T any](value T) Predicate[T] {
+	return is(func() string {
+		return call(`NotEq`, value)
+	}, func() string {
+		return `equal to ` + utils.String(value)
+	}, true, func(query T) bool {
+		return !comp.Equal(query, value)
+	})
+}
+
+// GreaterThan is a predicate which returns true if the value
+// passed into the predicate is greater than the given value.
+func GreaterThan[T any](value T, comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp := optional.Comparer(comparer)
+	return is(func() string {
+		return call(`GreaterThan`, value)
+	}, func() string {
+		return `> ` + utils.String(value)
+	}, false, func(query T) bool {
+		return cmp(query, value) > 0
+	})
+}
+
+// GreaterEq is a predicate which returns true if the value
+// passed into the predicate is greater than or equal to the given value.
+func GreaterEq[T any](value T, comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp := optional.Comparer(comparer)
+	return is(func() string {
+		return call(`GreaterEq`, value)
+	}, func() string {
+		return `>= ` + utils.String(value)
+	}, false, func(query T) bool {
+		return cmp(query, value) >= 0
+	})
+}
+
+// LessThan is a predicate which returns true if the value
+// passed into the predicate is less than the given value.
+func LessThan[T any](value T, comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp := optional.Comparer(comparer)
+	return is(func() string {
+		return call(`LessThan`, value)
+	}, func() string {
+		return `< ` + utils.String(value)
+	}, false, func(query T) bool {
+		return cmp(query, value) < 0
+	})
+}
+
+// LessEq is a predicate which returns true if the value
+// passed into the predicate is less than or equal to the given value.
+func LessEq[T any](value T, comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp := optional.Comparer(comparer)
+	return is(func() string {
+		return call(`LessEq`, value)
+	}, func() string {
+		return `<= ` + utils.String(value)
+	}, false, func(query T) bool {
+		return cmp(query, value) <= 0
+	})
+}
+
+// InRange is a predicate which returns true if the value passed
+// into the predicate is between the given min and maximum inclusively.
+func InRange[T any](minValue, maxValue T, comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp := optional.Comparer(comparer)
+	what := func() string {
+		return `in [` + utils.String(minValue) + `, ` + utils.String(maxValue) + `]`
+	}
+	return is(func() string {
+		return call(`InRange`, minValue, maxValue)
+	}, what, false, func(query T) bool {
+		return cmp(minValue, query) <= 0 && cmp(query, maxValue) <= 0
+	})
+}
+
+// EpsilonEq is a predicate which returns true if the value passed into the
+// predicate is within an epsilon value (inclusively) of the other value.
+//
+// This is useful for finding floating-point values which have lost precision
+// via calculations and will not be equal a literal, but will be very close to it.
+func EpsilonEq[T utils.NumConstraint](value, epsilon T) Predicate[T] {
+	cmp := comp.Epsilon(epsilon)
+	what := func() string {
+		return `within ` + utils.String(epsilon) + ` of ` + utils.String(value)
+	}
+	return is(func() string {
+		return call(`EpsilonEq`, value, epsilon)
+	}, what, false, func(query T) bool {
+		return cmp(query, value) == 0
+	})
+}
+
+// EpsilonNotEq is a predicate which returns true if the value passed into the
+// predicate is not within an epsilon value (inclusively) of the other value.
+//
+// This is useful for finding floating-point values which have lost precision
+// via calculations and will not be equal a literal, but will be very close to it.
+func EpsilonNotEq[T utils.NumConstraint](value, epsilon T) Predicate[T] {
+	cmp := comp.Epsilon(epsilon)
+	what := func() string {
+		return `within ` + utils.String(epsilon) + ` of ` + utils.String(value)
+	}
+	return is(func() string {
+		return call(`EpsilonNotEq`, value, epsilon)
+	}, what, true, func(query T) bool {
+		return cmp(query, value) != 0
+	})
+}
+
+// Pos is a predicate which returns true if the value
+// passed into the predicate is greater than zero, i.e. positive.
+func Pos[T any](comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp, zero := optional.Comparer(comparer), utils.Zero[T]()
+	return is(text(`Pos`), func() string {
+		return `> ` + utils.String(zero)
+	}, false, func(query T) bool {
+		return cmp(query, zero) > 0
+	})
+}
+
+// Neg is a predicate which returns true if the value
+// passed into the predicate is less than zero, i.e. negative,
+func Neg[T any](comparer ...comp.Comparer[T]) Predicate[T] {
+	cmp, zero := optional.Comparer(comparer), utils.Zero[T]()
+	return is(text(`Neg`), func() string {
+		return `< ` + utils.String(zero)
+	}, false, func(query T) bool {
+		return cmp(query, zero) < 0
+	})
+}
+
+// Field is a predicate which calls the given predicate with the value at
+// the given path inside of the value passed into the predicate.
+//
+// The path is the same as for `predicate.Field`. If the value at the end
+// of the path can not be reached, then this returns false.
+//
+// The path is checked against the types when the predicate is created.
+// This will panic if the path is malformed or isn't valid for the types.
+func Field[T, V any](path string, p Predicate[V]) Predicate[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	fp := fieldPath.New[T, V](path)
+	describe := func() string {
+		return call(`Field`, path)
+	}
+	return predicateImp[T]{
+		test: func(value T) bool {
+			v, ok := fp.Get(value)
+			return ok && p.Test(v)
+		},
+		explain: func(value T) Explanation {
+			v, ok := fp.Get(value)
+			if !ok {
+				return Explanation{
+					Passed:      false,
+					Description: describe(),
+					Reason:      `the path could not be reached`,
+				}
+			}
+			cause := p.Explain(v)
+			return Explanation{
+				Passed:      cause.Passed,
+				Description: describe(),
+				Reason:      `the value is ` + utils.String(v),
+				Causes:      []Explanation{cause},
+			}
+		},
+	}
+}
+
+// Not negates the result of the given predicate.
+func Not[T any](p Predicate[T]) Predicate[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	return predicateImp[T]{
+		test: func(value T) bool {
+			return !p.Test(value)
+		},
+		explain: func(value T) Explanation {
+			cause := p.Explain(value)
+			reason := `the predicate failed`
+			if cause.Passed {
+				reason = `the predicate passed`
+			}
+			return Explanation{
+				Passed:      !cause.Passed,
+				Description: `Not`,
+				Reason:      reason,
+				Causes:      []Explanation{cause},
+			}
+		},
+	}
+}
+
+// And is a predicate which returns true only if
+// all the given predicates are true.
+func And[T any](p ...Predicate[T]) Predicate[T] {
+	checkAll(p)
+	return predicateImp[T]{
+		test: func(value T) bool {
+			for _, pi := range p {
+				if !pi.Test(value) {
+					return false
+				}
+			}
+			return true
+		},
+		explain: func(value T) Explanation {
+			return explainAll(`And`, p, value, func(passed int) bool {
+				return passed == len(p)
+			})
+		},
+	}
+}
+
+// Or is a predicate which returns true if
+// any of the given predicated are true.
+func Or[T any](p ...Predicate[T]) Predicate[T] {
+	checkAll(p)
+	return predicateImp[T]{
+		test: func(value T) bool {
+			for _, pi := range p {
+				if pi.Test(value) {
+					return true
+				}
+			}
+			return false
+		},
+		explain: func(value T) Explanation {
+			return explainAll(`Or`, p, value, func(passed int) bool {
+				return passed > 0
+			})
+		},
+	}
+}
+
+// OnlyOne is a predicate which returns true if
+// only one of the given predicates are true.
+func OnlyOne[T any](p ...Predicate[T]) Predicate[T] {
+	checkAll(p)
+	return predicateImp[T]{
+		test: func(value T) bool {
+			found := false
+			for _, pi := range p {
+				if pi.Test(value) {
+					if found {
+						return false
+					}
+					found = true
+				}
+			}
+			return found
+		},
+		explain: func(value T) Explanation {
+			return explainAll(`OnlyOne`, p, value, func(passed int) bool {
+				return passed == 1
+			})
+		},
+	}
+}
+
+// checkAll panics if any of the given predicates are nil.
+func checkAll[T any](p []Predicate[T]) {
+	for i, pi := range p {
+		if utils.IsNil(pi) {
+			panic(terror.NilArg(`p`).
+				With(`index`, i))
+		}
+	}
+}
+
+// explainAll explains every one of the given predicates, even after
+// the result is known, so that all the causes are in the explanation.
+func explainAll[T any](description string, p []Predicate[T], value T, pass func(passed int) bool) Explanation {
+	causes := make([]Explanation, len(p))
+	passed := 0
+	for i, pi := range p {
+		causes[i] = pi.Explain(value)
+		if causes[i].Passed {
+			passed++
+		}
+	}
+	return Explanation{
+		Passed:      pass(passed),
+		Description: description,
+		Reason:      fmt.Sprintf(`%d of %d passed`, passed, len(p)),
+		Causes:      causes,
+	}
+}
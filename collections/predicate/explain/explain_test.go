@@ -0,0 +1,159 @@
+package explain
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+type (
+	address struct {
+		City string
+	}
+
+	person struct {
+		Name string
+		Home *address
+	}
+)
+
+func Test_Explain_Leaves(t *testing.T) {
+	checkExplain(t, GreaterThan(3), 2, `✗ GreaterThan(3): 2 is not > 3`)
+	checkExplain(t, GreaterThan(3), 5, `✓ GreaterThan(3): 5 is > 3`)
+	checkExplain(t, GreaterEq(3), 3, `✓ GreaterEq(3): 3 is >= 3`)
+	checkExplain(t, LessThan(3), 3, `✗ LessThan(3): 3 is not < 3`)
+	checkExplain(t, LessEq(3), 4, `✗ LessEq(3): 4 is not <= 3`)
+	checkExplain(t, InRange(1, 5), 7, `✗ InRange(1, 5): 7 is not in [1, 5]`)
+	checkExplain(t, Eq(`cat`), `dog`, `✗ Eq(cat): dog is not equal to cat`)
+	checkExplain(t, NotEq(3), 3, `✗ NotEq(3): 3 is equal to 3`)
+	checkExplain(t, NotEq(3), 4, `✓ NotEq(3): 4 is not equal to 3`)
+	checkExplain(t, EpsilonEq(1.0, 0.1), 1.2, `✗ EpsilonEq(1, 0.1): 1.2 is not within 0.1 of 1`)
+	checkExplain(t, EpsilonNotEq(1.0, 0.1), 1.05, `✗ EpsilonNotEq(1, 0.1): 1.05 is within 0.1 of 1`)
+	checkExplain(t, Pos[int](), -1, `✗ Pos: -1 is not > 0`)
+	checkExplain(t, Neg[int](), -1, `✓ Neg: -1 is < 0`)
+	checkExplain(t, IsNil[*int](), nil, `✓ IsNil: <nil> is nil`)
+	checkExplain(t, IsNotNil[*int](), nil, `✗ IsNotNil: <nil> is nil`)
+	checkExplain(t, IsZero[int](), 4, `✗ IsZero: 4 is not zero`)
+	checkExplain(t, IsNotZero[int](), 4, `✓ IsNotZero: 4 is not zero`)
+	checkExplain(t, IsTrue(), false, `✗ IsTrue: false is not true`)
+	checkExplain(t, IsFalse(), false, `✓ IsFalse: false is false`)
+	checkExplain(t, OfType[string, any](), 5, `✗ OfType[string]: int is not string`)
+	checkExplain(t, Matches(`^a`), `bat`, `✗ Matches(^a): "bat" does not match`)
+	checkExplain(t, In(1, 2, 3), 5, `✗ In(1, 2, 3): 5 is not one of the values`)
+	checkExplain(t, InMap(map[string]int{`a`: 1}), `a`, `✓ InMap: a is a key in the map`)
+	checkExplain(t, From(`Even`, func(v int) bool { return v%2 == 0 }), 3, `✗ Even: 3 was not accepted`)
+}
+
+func Test_Explain_Composite(t *testing.T) {
+	p := And(GreaterThan(3), LessThan(10), Not(Eq(7)))
+	checkTest(t, p, 5, true)
+	checkTest(t, p, 7, false)
+	checkTest(t, p, 12, false)
+	checkExplain(t, p, 12,
+		`✗ And: 2 of 3 passed`,
+		`  ✓ GreaterThan(3): 12 is > 3`,
+		`  ✗ LessThan(10): 12 is not < 10`,
+		`  ✓ Not: the predicate failed`,
+		`    ✗ Eq(7): 12 is not equal to 7`)
+
+	p = Or(LessThan(0), GreaterThan(100))
+	checkTest(t, p, 50, false)
+	checkTest(t, p, 150, true)
+	checkExplain(t, p, 50,
+		`✗ Or: 0 of 2 passed`,
+		`  ✗ LessThan(0): 50 is not < 0`,
+		`  ✗ GreaterThan(100): 50 is not > 100`)
+
+	p = OnlyOne(Pos[int](), Eq(4))
+	checkTest(t, p, 3, true)
+	checkTest(t, p, 4, false)
+	checkTest(t, p, -3, false)
+	checkExplain(t, p, 4,
+		`✗ OnlyOne: 2 of 2 passed`,
+		`  ✓ Pos: 4 is > 0`,
+		`  ✓ Eq(4): 4 is equal to 4`)
+
+	checkExplain(t, And[int](), 1, `✓ And: 0 of 0 passed`)
+
+	checkExplain(t, AsString(Matches(`^1`)), 42,
+		`✗ AsString: the string is "42"`,
+		`  ✗ Matches(^1): "42" does not match`)
+}
+
+func Test_Explain_Field(t *testing.T) {
+	p := Field[person](`Home.City`, Eq(`Paris`))
+	checkTest(t, p, person{Home: &address{City: `Paris`}}, true)
+	checkExplain(t, p, person{Home: &address{City: `Rome`}},
+		`✗ Field(Home.City): the value is Rome`,
+		`  ✗ Eq(Paris): Rome is not equal to Paris`)
+	checkExplain(t, p, person{},
+		`✗ Field(Home.City): the path could not be reached`)
+}
+
+func Test_Explain_Versions(t *testing.T) {
+	p := SemVerRange(`>=1.2 <2.0`)
+	checkTest(t, p, `1.4.0`, true)
+	checkTest(t, p, `2.1.0`, false)
+	checkExplain(t, p, `1.4.0`, `✓ SemVerRange(>=1.2 <2.0): 1.4.0 satisfies >=1.2 <2.0`)
+	checkExplain(t, p, `2.1.0`, `✗ SemVerRange(>=1.2 <2.0): 2.1.0 does not satisfy >=1.2 <2.0`)
+	checkExplain(t, p, `1.4`, `✗ SemVerRange(>=1.2 <2.0): 1.4 is not a valid semantic version`)
+	checkExplain(t, p, `2.0.0-rc.1`, `✗ SemVerRange(>=1.2 <2.0): 2.0.0-rc.1 does not satisfy >=1.2 <2.0`)
+
+	p = DottedRange(`1.2.10`)
+	checkExplain(t, p, `1.2.10`, `✓ DottedRange(1.2.10): 1.2.10 satisfies 1.2.10`)
+	checkExplain(t, p, `1.2.9`, `✗ DottedRange(1.2.10): 1.2.9 does not satisfy 1.2.10`)
+	checkExplain(t, p, `1.b`, `✗ DottedRange(1.2.10): 1.b is not a valid dotted identifier`)
+	checkExplain(t, p, `1.2.10.0`, `✓ DottedRange(1.2.10): 1.2.10.0 satisfies 1.2.10`)
+}
+
+func Test_Explain_Invalid(t *testing.T) {
+	checkPanic(t, func() { Not[int](nil) },
+		`argument may not be nil {name: p}`)
+	checkPanic(t, func() { And(Pos[int](), nil) },
+		`argument may not be nil {index: 1, name: p}`)
+	checkPanic(t, func() { From[int](`nil`, nil) },
+		`argument may not be nil {name: p}`)
+	checkPanic(t, func() { Matches(``) },
+		`may not used an empty pattern string`)
+}
+
+func checkTest[T any](t *testing.T, p Predicate[T], value T, exp bool) {
+	t.Helper()
+	if actual := p.Test(value); actual != exp {
+		t.Errorf("\nUnexpected result from predicate test:\n"+
+			"\tValue:    %v\n"+
+			"\tActual:   %t\n"+
+			"\tExpected: %t", value, actual, exp)
+	}
+	if actual := p.Explain(value).Passed; actual != exp {
+		t.Errorf("\nUnexpected result from predicate explanation:\n"+
+			"\tValue:    %v\n"+
+			"\tActual:   %t\n"+
+			"\tExpected: %t", value, actual, exp)
+	}
+}
+
+func checkExplain[T any](t *testing.T, p Predicate[T], value T, expLines ...string) {
+	t.Helper()
+	exp := strings.Join(expLines, "\n")
+	if actual := p.Explain(value).String(); actual != exp {
+		t.Errorf("\nUnexpected explanation:\n"+
+			"\tValue:    %v\n"+
+			"\tActual:   %s\n"+
+			"\tExpected: %s", value, actual, exp)
+	}
+}
+
+func checkPanic(t *testing.T, handle func(), exp string) {
+	t.Helper()
+	actual := func() (r string) {
+		defer func() { r = fmt.Sprint(recover()) }()
+		handle()
+		return ``
+	}()
+	if actual != exp {
+		t.Errorf("\nUnexpected panic:\n"+
+			"\tActual:   %s\n"+
+			"\tExpected: %s", actual, exp)
+	}
+}
@@ -0,0 +1,56 @@
+package explain
+
+import "strings"
+
+// Explanation is the result of testing a value with an explainable predicate.
+//
+// It describes the predicate, whether the value passed or failed,
+// and the reason why. Composite predicates, such as `And`,
+// include the explanations of their sub-predicates as causes.
+type Explanation struct {
+	// Passed indicates if the value was accepted by the predicate.
+	Passed bool
+
+	// Description describes the predicate, e.g. `GreaterThan(3)`.
+	Description string
+
+	// Reason describes why the value passed or failed, e.g. `2 is not > 3`.
+	Reason string
+
+	// Causes are the explanations from any sub-predicates.
+	Causes []Explanation
+}
+
+// String gets the explanation as a pass/fail tree,
+// with one line for this explanation and an indented line for each cause.
+//
+// Example:
+//
+//	✗ And: 1 of 2 passed
+//	  ✓ GreaterThan(3): 5 is > 3
+//	  ✗ LessThan(4): 5 is not < 4
+func (e Explanation) String() string {
+	buf := &strings.Builder{}
+	e.write(buf, ``)
+	return buf.String()
+}
+
+func (e Explanation) write(buf *strings.Builder, indent string) {
+	if indent != `` {
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(indent)
+	if e.Passed {
+		_, _ = buf.WriteString(`✓ `)
+	} else {
+		_, _ = buf.WriteString(`✗ `)
+	}
+	_, _ = buf.WriteString(e.Description)
+	if len(e.Reason) > 0 {
+		_, _ = buf.WriteString(`: `)
+		_, _ = buf.WriteString(e.Reason)
+	}
+	for _, cause := range e.Causes {
+		cause.write(buf, indent+`  `)
+	}
+}
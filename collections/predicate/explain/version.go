@@ -0,0 +1,51 @@
+package explain
+
+import "github.com/Snow-Gremlin/goToolbox/comp"
+
+// SemVerRange is a predicate which returns true if the value is a semantic
+// version, see `comp.ParseVersion`, which satisfies the given constraint,
+// see `comp.ParseSemVerConstraint` for the constraint syntax, e.g. `>=1.2 <2.0`.
+//
+// Values which aren't valid semantic versions never satisfy the constraint.
+// If the constraint is invalid, then this will panic.
+func SemVerRange(constraint string) Predicate[string] {
+	c, err := comp.ParseSemVerConstraint(constraint)
+	if err != nil {
+		panic(err)
+	}
+	return versionRange(`SemVerRange`, `a valid semantic version`, c)
+}
+
+// DottedRange is a predicate which returns true if the value is a dotted
+// numeric identifier, see `comp.ParseDotted`, which satisfies the given
+// constraint, see `comp.ParseDottedConstraint`, e.g. `>=1.2 <2.0`.
+//
+// Values which aren't valid dotted identifiers never satisfy the constraint.
+// If the constraint is invalid, then this will panic.
+func DottedRange(constraint string) Predicate[string] {
+	c, err := comp.ParseDottedConstraint(constraint)
+	if err != nil {
+		panic(err)
+	}
+	return versionRange(`DottedRange`, `a valid dotted identifier`, c)
+}
+
+// versionRange creates a predicate for the given version constraint.
+func versionRange(name, valid string, c comp.VersionConstraint) Predicate[string] {
+	constraint := c.String()
+	test := func(value string) bool {
+		ok, err := c.Satisfies(value)
+		return err == nil && ok
+	}
+	return leaf(func() string {
+		return call(name, constraint)
+	}, test, func(value string, passed bool) string {
+		if passed {
+			return value + ` satisfies ` + constraint
+		}
+		if _, err := c.Satisfies(value); err != nil {
+			return value + ` is not ` + valid
+		}
+		return value + ` does not satisfy ` + constraint
+	})
+}
@@ -0,0 +1,832 @@
+package predicate
+
+import (
+	"encoding"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/fieldPath"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+var (
+	anyType             = utils.TypeOf[any]()
+	boolType            = utils.TypeOf[bool]()
+	float64Type         = utils.TypeOf[float64]()
+	stringType          = utils.TypeOf[string]()
+	durationType        = utils.TypeOf[time.Duration]()
+	timeType            = utils.TypeOf[time.Time]()
+	textUnmarshalerType = utils.TypeOf[encoding.TextUnmarshaler]()
+)
+
+type tokenKind int
+
+const (
+	endToken tokenKind = iota
+	pathToken
+	numberToken
+	stringToken
+	symbolToken
+)
+
+// token is a part of an expression.
+// For string tokens the text is the unquoted string.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// is determines if this token is the given symbol or keyword.
+func (t token) is(text string) bool {
+	return (t.kind == symbolToken || t.kind == pathToken) && t.text == text
+}
+
+// isLiteral determines if this token is a literal value.
+func (t token) isLiteral() bool {
+	return t.kind == numberToken || t.kind == stringToken ||
+		t.is(`true`) || t.is(`false`) || t.is(`nil`)
+}
+
+func syntaxError(msg string, pos int) terrors.TError {
+	return terror.New(msg).
+		With(`position`, pos)
+}
+
+func tokenError(msg string, t token) terrors.TError {
+	if t.kind == endToken {
+		return syntaxError(msg, t.pos).
+			With(`token`, `end of expression`)
+	}
+	return syntaxError(msg, t.pos).
+		With(`token`, t.text)
+}
+
+var symbols = []string{
+	`==`, `!=`, `<=`, `>=`, `=~`, `!~`, `&&`, `||`,
+	`<`, `>`, `!`, `(`, `)`, `,`,
+}
+
+// tokenize splits the given expression into tokens.
+func tokenize(expr string) ([]token, terrors.TError) {
+	tokens := []token{}
+	afterOperand := func() bool {
+		if len(tokens) <= 0 {
+			return false
+		}
+		last := tokens[len(tokens)-1]
+		return last.kind != symbolToken || last.text == `)`
+	}
+
+	for pos := 0; pos < len(expr); {
+		c := expr[pos]
+		start := pos
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			pos++
+
+		case isIdentStart(c):
+			end, err := scanPath(expr, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: pathToken, text: expr[start:end], pos: start})
+			pos = end
+
+		case isDigit(c) || ((c == '-' || c == '.') && pos+1 < len(expr) && isDigit(expr[pos+1]) && !afterOperand()):
+			pos++
+			for pos < len(expr) && (isDigit(expr[pos]) || strings.IndexByte(`.eE`, expr[pos]) >= 0 ||
+				((expr[pos] == '-' || expr[pos] == '+') && (expr[pos-1] == 'e' || expr[pos-1] == 'E'))) {
+				pos++
+			}
+			text := expr[start:pos]
+			if _, err := strconv.ParseFloat(text, 64); err != nil {
+				return nil, syntaxError(`invalid number`, start).
+					With(`token`, text)
+			}
+			tokens = append(tokens, token{kind: numberToken, text: text, pos: start})
+
+		case c == '\'' || c == '"':
+			end, text, err := scanString(expr, pos)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: stringToken, text: text, pos: start})
+			pos = end
+
+		default:
+			found := false
+			for _, symbol := range symbols {
+				if strings.HasPrefix(expr[pos:], symbol) {
+					tokens = append(tokens, token{kind: symbolToken, text: symbol, pos: start})
+					pos += len(symbol)
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, syntaxError(`unexpected character`, start).
+					With(`character`, string(c))
+			}
+		}
+	}
+	return append(tokens, token{kind: endToken, pos: len(expr)}), nil
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentifier(name string) bool {
+	if len(name) <= 0 || !isIdentStart(name[0]) {
+		return false
+	}
+	for i := 1; i < len(name); i++ {
+		if !isIdentStart(name[i]) && !isDigit(name[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// scanPath reads a field path, or identifier, starting at the given position.
+// Returns the position after the path.
+func scanPath(expr string, pos int) (int, terrors.TError) {
+	scanIdent := func() {
+		for pos < len(expr) && (isIdentStart(expr[pos]) || isDigit(expr[pos])) {
+			pos++
+		}
+	}
+
+	scanIdent()
+	for pos < len(expr) {
+		switch {
+		case expr[pos] == '.' && pos+1 < len(expr) && isIdentStart(expr[pos+1]):
+			pos++
+			scanIdent()
+
+		case expr[pos] == '[':
+			start := pos
+			pos++
+			if pos < len(expr) && expr[pos] == '"' {
+				end, _, err := scanString(expr, pos)
+				if err != nil {
+					return 0, err
+				}
+				pos = end
+			}
+			for pos < len(expr) && expr[pos] != ']' {
+				pos++
+			}
+			if pos >= len(expr) {
+				return 0, syntaxError(`expected a closing bracket`, start)
+			}
+			pos++
+
+		default:
+			return pos, nil
+		}
+	}
+	return pos, nil
+}
+
+// scanString reads a quoted string starting at the given position.
+// Returns the position after the string and the unquoted string.
+func scanString(expr string, pos int) (int, string, terrors.TError) {
+	start := pos
+	quote := expr[pos]
+	buf := &strings.Builder{}
+	for pos++; pos < len(expr); pos++ {
+		c := expr[pos]
+		switch {
+		case c == quote:
+			return pos + 1, buf.String(), nil
+		case c == '\\' && pos+1 < len(expr):
+			pos++
+			switch c = expr[pos]; c {
+			case 'n':
+				buf.WriteByte('\n')
+			case 't':
+				buf.WriteByte('\t')
+			case 'r':
+				buf.WriteByte('\r')
+			case '\\', '\'', '"':
+				buf.WriteByte(c)
+			default:
+				buf.WriteByte('\\')
+				buf.WriteByte(c)
+			}
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return 0, ``, syntaxError(`expected a closing quote`, start)
+}
+
+// getter gets a value from the value passed into a predicate.
+// Returns false if the value can not be reached.
+type getter[T any] func(value T) (any, bool)
+
+// operand is a value being compared or passed into a function.
+// Untyped literals are kept as a token until the type they
+// should be converted to is known.
+type operand[T any] struct {
+	tok token
+	typ reflect.Type
+	get getter[T]
+}
+
+func (o operand[T]) isLiteral() bool {
+	return o.get == nil
+}
+
+// parser is a recursive descent parser for predicate expressions.
+type parser[T any] struct {
+	tokens []token
+	index  int
+	funcs  map[string]reflect.Value
+}
+
+func (p *parser[T]) peek() token {
+	return p.tokens[p.index]
+}
+
+func (p *parser[T]) next() token {
+	t := p.tokens[p.index]
+	if t.kind != endToken {
+		p.index++
+	}
+	return t
+}
+
+func (p *parser[T]) expect(symbol string) terrors.TError {
+	if t := p.next(); !t.is(symbol) {
+		return tokenError(`expected `+symbol, t)
+	}
+	return nil
+}
+
+func (p *parser[T]) parse() (collections.Predicate[T], terrors.TError) {
+	pred, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != endToken {
+		return nil, tokenError(`unexpected token`, t)
+	}
+	return pred, nil
+}
+
+func (p *parser[T]) parseOr() (collections.Predicate[T], terrors.TError) {
+	preds := []collections.Predicate[T]{}
+	for {
+		pred, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+		if !p.peek().is(`||`) {
+			break
+		}
+		p.next()
+	}
+	if len(preds) == 1 {
+		return preds[0], nil
+	}
+	return Or(preds...), nil
+}
+
+func (p *parser[T]) parseAnd() (collections.Predicate[T], terrors.TError) {
+	preds := []collections.Predicate[T]{}
+	for {
+		pred, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		preds = append(preds, pred)
+		if !p.peek().is(`&&`) {
+			break
+		}
+		p.next()
+	}
+	if len(preds) == 1 {
+		return preds[0], nil
+	}
+	return And(preds...), nil
+}
+
+func (p *parser[T]) parseNot() (collections.Predicate[T], terrors.TError) {
+	switch t := p.peek(); {
+	case t.is(`!`):
+		p.next()
+		pred, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return Not(pred), nil
+
+	case t.is(`(`):
+		p.next()
+		pred, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(`)`); err != nil {
+			return nil, err
+		}
+		return pred, nil
+
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *parser[T]) parseComparison() (collections.Predicate[T], terrors.TError) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.peek()
+	switch {
+	case op.is(`==`), op.is(`!=`), op.is(`<`), op.is(`<=`), op.is(`>`), op.is(`>=`):
+		p.next()
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return p.compare(left, op, right)
+
+	case op.is(`=~`), op.is(`!~`):
+		p.next()
+		return p.match(left, op)
+
+	case op.is(`in`):
+		p.next()
+		return p.in(left)
+
+	case op.is(`not`):
+		p.next()
+		if err := p.expect(`in`); err != nil {
+			return nil, err
+		}
+		pred, err := p.in(left)
+		if err != nil {
+			return nil, err
+		}
+		return Not(pred), nil
+
+	default:
+		return p.truth(left)
+	}
+}
+
+// parseOperand reads a literal, field path, or function call.
+func (p *parser[T]) parseOperand() (operand[T], terrors.TError) {
+	t := p.next()
+	switch {
+	case t.isLiteral():
+		return operand[T]{tok: t}, nil
+
+	case t.kind == pathToken:
+		if t.is(`in`) || t.is(`not`) {
+			return operand[T]{}, tokenError(`expected a value`, t)
+		}
+		if p.peek().is(`(`) && isIdentifier(t.text) {
+			return p.parseCall(t)
+		}
+		path, err := fieldPath.Parse[T, any](t.text, true)
+		if err != nil {
+			return operand[T]{}, syntaxError(`invalid field`, t.pos).
+				WithError(err)
+		}
+		return operand[T]{
+			tok: t,
+			typ: path.Type(),
+			get: path.Get,
+		}, nil
+
+	default:
+		return operand[T]{}, tokenError(`expected a value`, t)
+	}
+}
+
+// parseCall reads the arguments for a call to the function with the given name.
+func (p *parser[T]) parseCall(name token) (operand[T], terrors.TError) {
+	fn, ok := p.funcs[name.text]
+	if !ok {
+		return operand[T]{}, syntaxError(`unknown function`, name.pos).
+			With(`function`, name.text)
+	}
+	p.next() // (
+
+	args := []operand[T]{}
+	if p.peek().is(`)`) {
+		p.next()
+	} else {
+		for {
+			arg, err := p.parseOperand()
+			if err != nil {
+				return operand[T]{}, err
+			}
+			args = append(args, arg)
+			if t := p.next(); t.is(`)`) {
+				break
+			} else if !t.is(`,`) {
+				return operand[T]{}, tokenError(`expected a comma or closing parenthesis`, t)
+			}
+		}
+	}
+
+	ft := fn.Type()
+	count := ft.NumIn()
+	if (ft.IsVariadic() && len(args) < count-1) || (!ft.IsVariadic() && len(args) != count) {
+		return operand[T]{}, syntaxError(`wrong number of arguments for function`, name.pos).
+			With(`function`, name.text).
+			With(`expected`, count).
+			With(`count`, len(args))
+	}
+
+	gets := make([]getter[T], len(args))
+	types := make([]reflect.Type, len(args))
+	for i, arg := range args {
+		typ := ft.In(min(i, count-1))
+		if ft.IsVariadic() && i >= count-1 {
+			typ = typ.Elem()
+		}
+		get, err := p.resolve(arg, typ)
+		if err != nil {
+			return operand[T]{}, err.
+				With(`function`, name.text).
+				With(`argument`, i)
+		}
+		gets[i], types[i] = get, typ
+	}
+
+	return operand[T]{
+		tok: name,
+		typ: ft.Out(0),
+		get: func(value T) (any, bool) {
+			in := make([]reflect.Value, len(gets))
+			for i, get := range gets {
+				arg, ok := get(value)
+				if !ok {
+					return nil, false
+				}
+				in[i] = valueAs(arg, types[i])
+			}
+			return fn.Call(in)[0].Interface(), true
+		},
+	}, nil
+}
+
+// resolve gets the getter for the given operand as a value of the given type.
+// A literal is converted into the type. Any other operand must have
+// a type which is assignable to the type or has the same kind.
+func (p *parser[T]) resolve(o operand[T], typ reflect.Type) (getter[T], terrors.TError) {
+	if o.isLiteral() {
+		value, err := literal(o.tok, typ)
+		if err != nil {
+			return nil, err
+		}
+		return constant[T](value), nil
+	}
+	if o.typ.AssignableTo(typ) ||
+		(o.typ.Kind() == typ.Kind() && o.typ.ConvertibleTo(typ) && typ.Kind() != reflect.Interface) {
+		return o.get, nil
+	}
+	return nil, tokenError(`the value is not the expected type`, o.tok).
+		With(`type`, o.typ).
+		With(`expected`, typ)
+}
+
+func constant[T any](value any) getter[T] {
+	return func(T) (any, bool) {
+		return value, true
+	}
+}
+
+// valueAs gets the given value as a reflected value of the given type.
+func valueAs(value any, typ reflect.Type) reflect.Value {
+	if value == nil {
+		return reflect.Zero(typ)
+	}
+	v := reflect.ValueOf(value)
+	if !v.Type().AssignableTo(typ) {
+		v = v.Convert(typ)
+	}
+	return v
+}
+
+// defaultType gets the type a literal has when there is
+// no other type for the literal to be converted into.
+func defaultType(t token) reflect.Type {
+	switch {
+	case t.kind == numberToken:
+		return float64Type
+	case t.kind == stringToken:
+		return stringType
+	case t.is(`nil`):
+		return anyType
+	default:
+		return boolType
+	}
+}
+
+// literal converts the given literal token into a value of the given type.
+func literal(t token, typ reflect.Type) (any, terrors.TError) {
+	if typ.Kind() == reflect.Interface {
+		if t.is(`nil`) {
+			return nil, nil
+		}
+		if dt := defaultType(t); dt.AssignableTo(typ) {
+			return literal(t, dt)
+		}
+	}
+
+	v := reflect.New(typ).Elem()
+	var err error
+	switch {
+	case t.is(`nil`):
+		switch typ.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Map, reflect.Pointer, reflect.Slice:
+			return v.Interface(), nil
+		}
+
+	case t.is(`true`), t.is(`false`):
+		if typ.Kind() == reflect.Bool {
+			v.SetBool(t.is(`true`))
+			return v.Interface(), nil
+		}
+
+	case t.kind == numberToken:
+		switch typ.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var i int64
+			if i, err = strconv.ParseInt(t.text, 10, typ.Bits()); err == nil {
+				v.SetInt(i)
+				return v.Interface(), nil
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			var u uint64
+			if u, err = strconv.ParseUint(t.text, 10, typ.Bits()); err == nil {
+				v.SetUint(u)
+				return v.Interface(), nil
+			}
+		case reflect.Float32, reflect.Float64:
+			var f float64
+			if f, err = strconv.ParseFloat(t.text, typ.Bits()); err == nil {
+				v.SetFloat(f)
+				return v.Interface(), nil
+			}
+		}
+
+	case t.kind == stringToken:
+		switch {
+		case typ == durationType:
+			var d time.Duration
+			if d, err = time.ParseDuration(t.text); err == nil {
+				return d, nil
+			}
+		case typ == timeType:
+			var tm time.Time
+			if tm, err = time.Parse(time.RFC3339, t.text); err != nil {
+				tm, err = time.Parse(time.DateOnly, t.text)
+			}
+			if err == nil {
+				return tm, nil
+			}
+		case reflect.PointerTo(typ).Implements(textUnmarshalerType):
+			if err = v.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(t.text)); err == nil {
+				return v.Interface(), nil
+			}
+		case typ.Kind() == reflect.String:
+			v.SetString(t.text)
+			return v.Interface(), nil
+		}
+	}
+
+	e := syntaxError(`the literal can not be used as the expected type`, t.pos).
+		With(`literal`, t.text).
+		With(`expected`, typ)
+	if err != nil {
+		e.WithError(err)
+	}
+	return nil, e
+}
+
+// unify gets the getters and type for comparing the two given operands.
+// A literal is converted to the type of the other operand.
+func (p *parser[T]) unify(left, right operand[T]) (getter[T], getter[T], reflect.Type, terrors.TError) {
+	switch {
+	case left.isLiteral() && right.isLiteral():
+		typ := defaultType(left.tok)
+		if rt := defaultType(right.tok); typ == anyType {
+			typ = rt
+		}
+		left.typ, left.get = typ, nil
+		right.typ, right.get = typ, nil
+	case left.isLiteral():
+		left.typ = right.typ
+	case right.isLiteral():
+		right.typ = left.typ
+	case left.typ != right.typ:
+		return nil, nil, nil, tokenError(`the values are not the same type`, right.tok).
+			With(`left type`, left.typ).
+			With(`right type`, right.typ)
+	}
+
+	lget, err := p.resolve(left, left.typ)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	rget, err := p.resolve(right, right.typ)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return lget, rget, left.typ, nil
+}
+
+// compare creates a predicate which compares two operands with the given operator.
+func (p *parser[T]) compare(left operand[T], op token, right operand[T]) (collections.Predicate[T], terrors.TError) {
+	lget, rget, typ, err := p.unify(left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := comparerFor(typ)
+	var test func(x, y any) bool
+	switch op.text {
+	case `==`:
+		test = equalizer(cmp)
+	case `!=`:
+		eq := equalizer(cmp)
+		test = func(x, y any) bool { return !eq(x, y) }
+	default:
+		if cmp == nil {
+			return nil, tokenError(`the values can not be ordered`, op).
+				With(`type`, typ)
+		}
+		switch op.text {
+		case `<`:
+			test = func(x, y any) bool { return cmp(x, y) < 0 }
+		case `<=`:
+			test = func(x, y any) bool { return cmp(x, y) <= 0 }
+		case `>`:
+			test = func(x, y any) bool { return cmp(x, y) > 0 }
+		default: // >=
+			test = func(x, y any) bool { return cmp(x, y) >= 0 }
+		}
+	}
+
+	return func(value T) bool {
+		x, ok := lget(value)
+		if !ok {
+			return false
+		}
+		y, ok := rget(value)
+		return ok && test(x, y)
+	}, nil
+}
+
+// match creates a predicate which matches an operand
+// against a regular expression pattern.
+func (p *parser[T]) match(left operand[T], op token) (collections.Predicate[T], terrors.TError) {
+	t := p.next()
+	if t.kind != stringToken {
+		return nil, tokenError(`expected a regular expression pattern string`, t)
+	}
+	re, err := regexp.Compile(t.text)
+	if err != nil {
+		return nil, tokenError(`invalid regular expression pattern`, t).
+			WithError(err)
+	}
+	get, e := p.resolve(left, stringType)
+	if e != nil {
+		return nil, e
+	}
+	negate := op.is(`!~`)
+	return func(value T) bool {
+		x, ok := get(value)
+		return ok && re.MatchString(reflect.ValueOf(x).String()) != negate
+	}, nil
+}
+
+// in creates a predicate which checks if an operand
+// is equal to any of the values in a parenthesized list.
+func (p *parser[T]) in(left operand[T]) (collections.Predicate[T], terrors.TError) {
+	if err := p.expect(`(`); err != nil {
+		return nil, err
+	}
+	eq := token{kind: symbolToken, text: `==`}
+	preds := []collections.Predicate[T]{}
+	if p.peek().is(`)`) {
+		p.next()
+	} else {
+		for {
+			right, err := p.parseOperand()
+			if err != nil {
+				return nil, err
+			}
+			eq.pos = right.tok.pos
+			pred, err := p.compare(left, eq, right)
+			if err != nil {
+				return nil, err
+			}
+			preds = append(preds, pred)
+			if t := p.next(); t.is(`)`) {
+				break
+			} else if !t.is(`,`) {
+				return nil, tokenError(`expected a comma or closing parenthesis`, t)
+			}
+		}
+	}
+	return Or(preds...), nil
+}
+
+// truth creates a predicate from a boolean operand
+// which is used without a comparison.
+func (p *parser[T]) truth(o operand[T]) (collections.Predicate[T], terrors.TError) {
+	if !o.isLiteral() && o.typ.Kind() != reflect.Bool {
+		return nil, tokenError(`expected a comparison`, p.peek()).
+			With(`type`, o.typ)
+	}
+	get, err := p.resolve(o, boolType)
+	if err != nil {
+		return nil, err
+	}
+	return func(value T) bool {
+		x, ok := get(value)
+		return ok && reflect.ValueOf(x).Bool()
+	}, nil
+}
+
+// equalizer gets an equality test from the given comparer,
+// or uses `comp.Equal` if there is no comparer.
+func equalizer(cmp func(x, y any) int) func(x, y any) bool {
+	if cmp == nil {
+		return comp.Equal[any]
+	}
+	return func(x, y any) bool {
+		return cmp(x, y) == 0
+	}
+}
+
+// comparerFor gets a comparer for values of the given type
+// using `comp.Default`. Named types are compared by their kind.
+// Returns nil if the values of the type can not be ordered.
+func comparerFor(typ reflect.Type) func(x, y any) int {
+	switch typ {
+	case durationType:
+		return anyComparer(comp.Default[time.Duration]())
+	case timeType:
+		return anyComparer(comp.Default[time.Time]())
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return kindComparer(comp.Default[bool](), reflect.Value.Bool)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return kindComparer(comp.Default[int64](), reflect.Value.Int)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return kindComparer(comp.Default[uint64](), reflect.Value.Uint)
+	case reflect.Float32, reflect.Float64:
+		return kindComparer(comp.Default[float64](), reflect.Value.Float)
+	case reflect.String:
+		return kindComparer(comp.Default[string](), reflect.Value.String)
+	}
+
+	if typ.Kind() == reflect.Interface {
+		return nil
+	}
+	if m, ok := typ.MethodByName(`CompareTo`); ok && m.Type.NumIn() == 2 && m.Type.In(1) == typ &&
+		m.Type.NumOut() == 1 && m.Type.Out(0).Kind() == reflect.Int {
+		return func(x, y any) int {
+			return int(m.Func.Call([]reflect.Value{reflect.ValueOf(x), reflect.ValueOf(y)})[0].Int())
+		}
+	}
+	return nil
+}
+
+func anyComparer[T any](cmp comp.Comparer[T]) func(x, y any) int {
+	return func(x, y any) int {
+		return cmp(x.(T), y.(T))
+	}
+}
+
+func kindComparer[T any](cmp comp.Comparer[T], get func(reflect.Value) T) func(x, y any) int {
+	return func(x, y any) int {
+		return cmp(get(reflect.ValueOf(x)), get(reflect.ValueOf(y)))
+	}
+}
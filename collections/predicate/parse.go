@@ -0,0 +1,111 @@
+package predicate
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// Funcs is a set of custom functions, keyed by name,
+// which may be called in an expression given to `Parse`.
+//
+// Each function must be a Go function which returns exactly one value,
+// e.g. `func(s string, n int) bool`. The arguments are checked against
+// the parameters of the function when the expression is parsed.
+// A custom function will replace a built-in function with the same name.
+type Funcs map[string]any
+
+// builtinFuncs are the functions which may be called in any expression.
+var builtinFuncs = Funcs{
+	`len`: func(value any) int {
+		v := reflect.ValueOf(value)
+		switch v.Kind() {
+		case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+			return v.Len()
+		default:
+			return 0
+		}
+	},
+	`lower`:     strings.ToLower,
+	`upper`:     strings.ToUpper,
+	`contains`:  strings.Contains,
+	`hasPrefix`: strings.HasPrefix,
+	`hasSuffix`: strings.HasSuffix,
+}
+
+// Parse compiles the given expression into a predicate,
+// e.g. `age >= 30 && name =~ '^A' && tag in ('x', 'y')`.
+//
+// The expression is made of comparisons joined with `&&` and `||`,
+// negated with `!`, and grouped with parentheses. The comparisons are
+// `==`, `!=`, `<`, `<=`, `>`, `>=`, `=~` and `!~` for regular expressions,
+// and `in` and `not in` for a parenthesized list of values.
+// A value may be a number, a single or double quoted string, `true`, `false`,
+// `nil`, a path to a field of the value passed into the predicate,
+// or a call to a function. A boolean value may be used without a comparison.
+//
+// Field paths are the same as for `Field`, e.g. `address.city`, `tags[0]`,
+// or `scores["math"]`, except that field names which don't match exactly
+// are matched without case. The values are compared with the default comparer
+// for their type, see `comp.Default`. A literal value is converted to the type
+// of the value it is compared against, e.g. `'5s'` to a `time.Duration`.
+// If a field can not be reached, such as when a pointer along the path is nil,
+// then any comparison using that field is false.
+//
+// The built-in functions are `len`, `lower`, `upper`, `contains`,
+// `hasPrefix`, and `hasSuffix`. Optional custom functions may be given.
+//
+// Returns an error with the position in the expression if the expression
+// is malformed or isn't valid for the type being tested.
+// This will panic if any custom function isn't valid.
+func Parse[T any](expr string, funcs ...Funcs) (collections.Predicate[T], error) {
+	if count := len(funcs); count > 1 {
+		panic(terror.InvalidArgCount(1, count, `funcs`))
+	}
+	fs := map[string]reflect.Value{}
+	for name, fn := range builtinFuncs {
+		fs[name] = reflect.ValueOf(fn)
+	}
+	if len(funcs) > 0 {
+		for name, fn := range funcs[0] {
+			fs[name] = validFunc(name, fn)
+		}
+	}
+
+	tokens, err := tokenize(expr)
+	if err == nil {
+		p := &parser[T]{
+			tokens: tokens,
+			funcs:  fs,
+		}
+		var pred collections.Predicate[T]
+		if pred, err = p.parse(); err == nil {
+			return pred, nil
+		}
+	}
+	return nil, terror.New(`failed to parse predicate`, err).
+		With(`expression`, expr)
+}
+
+// validFunc checks that the given custom function is a function
+// which returns one value. This will panic if it isn't.
+func validFunc(name string, fn any) reflect.Value {
+	v := reflect.ValueOf(fn)
+	if !isIdentifier(name) {
+		panic(terror.New(`the name of a custom function must be an identifier`).
+			With(`name`, name))
+	}
+	if v.Kind() != reflect.Func || v.IsNil() {
+		panic(terror.New(`a custom function must be a function`).
+			With(`name`, name).
+			WithType(`type`, fn))
+	}
+	if v.Type().NumOut() != 1 {
+		panic(terror.New(`a custom function must return exactly one value`).
+			With(`name`, name).
+			With(`type`, v.Type()))
+	}
+	return v
+}
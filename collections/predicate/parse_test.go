@@ -0,0 +1,197 @@
+package predicate
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type (
+	level int
+
+	member struct {
+		Name    string
+		Age     int
+		Score   float64
+		Active  bool
+		Tag     string
+		Tags    []string
+		Level   level
+		Timeout time.Duration
+		Joined  time.Time
+		Boss    *member
+		Extra   map[string]int
+	}
+)
+
+func testMembers() (alice, bob member) {
+	alice = member{
+		Name:    `Alice`,
+		Age:     34,
+		Score:   91.5,
+		Active:  true,
+		Tag:     `x`,
+		Tags:    []string{`admin`, `dev`},
+		Level:   3,
+		Timeout: 5 * time.Second,
+		Joined:  time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+		Extra:   map[string]int{`rank`: 2},
+	}
+	bob = member{
+		Name:    `bob`,
+		Age:     27,
+		Score:   78,
+		Tag:     `z`,
+		Level:   1,
+		Timeout: time.Minute,
+		Joined:  time.Date(2023, 7, 15, 0, 0, 0, 0, time.UTC),
+		Boss:    &alice,
+	}
+	return alice, bob
+}
+
+func mustParse[T any](t *testing.T, expr string, funcs ...Funcs) collections.Predicate[T] {
+	t.Helper()
+	p, err := Parse[T](expr, funcs...)
+	if err != nil {
+		t.Fatalf("\nUnexpected error from parse:\n"+
+			"\tExpression: %s\n"+
+			"\tError:      %v", expr, err)
+	}
+	return p
+}
+
+func checkParse(t *testing.T, expr string, expAlice, expBob bool) {
+	t.Helper()
+	alice, bob := testMembers()
+	p := mustParse[member](t, expr)
+	checkPred(t, p, alice, expAlice)
+	checkPred(t, p, bob, expBob)
+}
+
+func checkParseError(t *testing.T, expr, exp string) {
+	t.Helper()
+	_, err := Parse[member](expr)
+	if actual := utils.String(err); actual != `failed to parse predicate {expression: `+expr+`}: `+exp {
+		t.Errorf("\nUnexpected error from parse:\n"+
+			"\tExpression: %s\n"+
+			"\tActual:     %s\n"+
+			"\tExpected:   %s", expr, actual, exp)
+	}
+}
+
+func Test_Predicate_Parse(t *testing.T) {
+	checkParse(t, `age >= 30`, true, false)
+	checkParse(t, `Age < 30`, false, true)
+	checkParse(t, `age >= 30 && name =~ '^A' && tag in ('x','y')`, true, false)
+	checkParse(t, `name !~ "^A"`, false, true)
+	checkParse(t, `tag not in ('x', 'y')`, false, true)
+	checkParse(t, `tag in ()`, false, false)
+	checkParse(t, `active`, true, false)
+	checkParse(t, `!active`, false, true)
+	checkParse(t, `active == false || score > 90`, true, true)
+	checkParse(t, `!(age > 30 || score < 80) && true`, false, false)
+	checkParse(t, `score >= 78.0 && score <= -1e3`, false, false)
+	checkParse(t, `score != 78`, true, false)
+	checkParse(t, `level > 2`, true, false)
+	checkParse(t, `timeout < '10s'`, true, false)
+	checkParse(t, `joined >= '2021-01-01'`, false, true)
+	checkParse(t, `joined < '2021-01-01T00:00:00Z'`, true, false)
+	checkParse(t, `boss == nil`, true, false)
+	checkParse(t, `boss.name == 'Alice'`, false, true)
+	checkParse(t, `boss.name != 'Alice'`, false, false)
+	checkParse(t, `tags[1] == "dev"`, true, false)
+	checkParse(t, `extra["rank"] == 2`, true, false)
+	checkParse(t, `len(tags) == 2`, true, false)
+	checkParse(t, `len(name) == 3 || upper(name) == 'ALICE'`, true, true)
+	checkParse(t, `contains(lower(name), 'li')`, true, false)
+	checkParse(t, `1 < 2 && 'a' == 'a' && nil == nil`, true, true)
+}
+
+func Test_Predicate_Parse_Funcs(t *testing.T) {
+	alice, bob := testMembers()
+	funcs := Funcs{
+		`initial`: func(s string) string {
+			return s[:1]
+		},
+		`between`: func(value, low, high int) bool {
+			return low <= value && value <= high
+		},
+		`any`: func(value string, options ...string) bool {
+			for _, option := range options {
+				if strings.EqualFold(value, option) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+
+	p := mustParse[member](t, `initial(name) == 'A'`, funcs)
+	checkPred(t, p, alice, true)
+	checkPred(t, p, bob, false)
+
+	p = mustParse[member](t, `between(age, 20, 30)`, funcs)
+	checkPred(t, p, alice, false)
+	checkPred(t, p, bob, true)
+
+	p = mustParse[member](t, `any(name, 'BOB', 'carl')`, funcs)
+	checkPred(t, p, alice, false)
+	checkPred(t, p, bob, true)
+
+	p = mustParse[member](t, `any(name)`, funcs)
+	checkPred(t, p, alice, false)
+
+	checkPanic(t, func() {
+		_, _ = Parse[member](`true`, Funcs{`bad`: 42})
+	}, `a custom function must be a function {name: bad, type: int}`)
+	checkPanic(t, func() {
+		_, _ = Parse[member](`true`, Funcs{`none`: func() {}})
+	}, `a custom function must return exactly one value {name: none, type: func()}`)
+	checkPanic(t, func() {
+		_, _ = Parse[member](`true`, Funcs{`not valid`: strings.ToLower})
+	}, `the name of a custom function must be an identifier {name: not valid}`)
+}
+
+func Test_Predicate_Parse_Errors(t *testing.T) {
+	checkParseError(t, `age >=`,
+		`expected a value {position: 6, token: end of expression}`)
+	checkParseError(t, `age >= 30 name`,
+		`unexpected token {position: 10, token: name}`)
+	checkParseError(t, `(age >= 30`,
+		`expected ) {position: 10, token: end of expression}`)
+	checkParseError(t, `age # 30`,
+		`unexpected character {character: #, position: 4}`)
+	checkParseError(t, `name == 'Alice`,
+		`expected a closing quote {position: 8}`)
+	checkParseError(t, `tags[0 == 'a'`,
+		`expected a closing bracket {position: 4}`)
+	checkParseError(t, `height > 3`,
+		`invalid field {position: 0}: no field with the given name exists {part: height, path: height, type: predicate.member}`)
+	checkParseError(t, `age > 'old'`,
+		`the literal can not be used as the expected type {expected: int, literal: old, position: 6}`)
+	checkParseError(t, `age > 3.5`,
+		`the literal can not be used as the expected type {expected: int, literal: 3.5, position: 6}: `+
+			`strconv.ParseInt: parsing "3.5": invalid syntax: invalid syntax`)
+	checkParseError(t, `age == name`,
+		`the values are not the same type {left type: int, position: 7, right type: string, token: name}`)
+	checkParseError(t, `boss > nil`,
+		`the values can not be ordered {position: 5, token: >, type: *predicate.member}`)
+	checkParseError(t, `age =~ '^3'`,
+		`the value is not the expected type {expected: string, position: 0, token: age, type: int}`)
+	checkParseError(t, `name =~ '('`,
+		`invalid regular expression pattern {position: 8, token: (}: error parsing regexp: missing closing ): `+"`(`")
+	checkParseError(t, `name`,
+		`expected a comparison {position: 4, token: end of expression, type: string}`)
+	checkParseError(t, `missing(name)`,
+		`unknown function {function: missing, position: 0}`)
+	checkParseError(t, `lower(name, 'x') == 'a'`,
+		`wrong number of arguments for function {count: 2, expected: 1, function: lower, position: 0}`)
+	checkParseError(t, `lower(age) == 'a'`,
+		`the value is not the expected type {argument: 0, expected: string, function: lower, position: 6, token: age, type: int}`)
+	checkParseError(t, `tag not ('x')`,
+		`expected in {position: 8, token: (}`)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/internal/fieldPath"
 	"github.com/Snow-Gremlin/goToolbox/internal/optional"
 	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
@@ -20,9 +21,11 @@ func IsNil[T any]() collections.Predicate[T] {
 }
 
 // IsNotNil is a predicate which returns true if the given value is not nil.
-// This will return false if the value is not nil-able.
+// This will return true if the value is not nil-able.
 func IsNotNil[T any]() collections.Predicate[T] {
-	return Not(IsNil[T]())
+	return func(value T) bool {
+		return !utils.IsNil(value)
+	}
 }
 
 // IsZero is a predicate which returns true if the the value is zero.
@@ -30,9 +33,11 @@ func IsZero[T any]() collections.Predicate[T] {
 	return utils.IsZero[T]
 }
 
-// IsNotZero is a predicate which returns true if the the value is zero.
+// IsNotZero is a predicate which returns true if the the value is not zero.
 func IsNotZero[T any]() collections.Predicate[T] {
-	return Not(IsZero[T]())
+	return func(value T) bool {
+		return !utils.IsZero(value)
+	}
 }
 
 // IsTrue is a predicate which returns true if the the value is true.
@@ -111,7 +116,9 @@ func Eq[T any](value T) collections.Predicate[T] {
 // NotEq is a predicate which returns true if the given value
 // is not equal to the value passed into the predicate.
 func NotEq[T any](value T) collections.Predicate[T] {
-	return Not(Eq(value))
+	return func(query T) bool {
+		return !comp.Equal(query, value)
+	}
 }
 
 // GreaterThan is a predicate which returns true if the value
@@ -159,6 +166,24 @@ func InRange[T any](minValue, maxValue T, comparer ...comp.Comparer[T]) collecti
 	}
 }
 
+// SemVerRange is a predicate which returns true if the value is a semantic
+// version which satisfies the given constraint, e.g. `>=1.2 <2.0`.
+// See `comp.ParseSemVerConstraint` for the constraint syntax.
+//
+// If the constraint is invalid, then this will panic.
+func SemVerRange(constraint string) collections.Predicate[string] {
+	return versionRange(comp.ParseSemVerConstraint(constraint))
+}
+
+// DottedRange is a predicate which returns true if the value is a dotted
+// numeric identifier which satisfies the given constraint, e.g. `>=1.2 <2.0`.
+// See `comp.ParseDottedConstraint` for the constraint syntax.
+//
+// If the constraint is invalid, then this will panic.
+func DottedRange(constraint string) collections.Predicate[string] {
+	return versionRange(comp.ParseDottedConstraint(constraint))
+}
+
 // EpsilonEq is a predicate which returns true if the value passed into the
 // predicate is within an epsilon value (inclusively) of the other value.
 //
@@ -201,6 +226,29 @@ func Neg[T any](comparer ...comp.Comparer[T]) collections.Predicate[T] {
 	}
 }
 
+// Field is a predicate which calls the given predicate with the value at
+// the given path inside of the value passed into the predicate.
+//
+// The path is made of field names separated by dots, e.g. `Address.City`,
+// and indices or map keys in square brackets, e.g. `Tags[0]` or `Scores[math]`.
+// A map key may be quoted, e.g. `Scores["a.b"]`, to contain any characters.
+// Pointers along the path are followed. If the value at the end of the path
+// can not be reached, such as when a pointer is nil, an index is out-of-bounds,
+// or a map doesn't have a key, then this returns false.
+//
+// The path is checked against the types when the predicate is created.
+// This will panic if the path is malformed or isn't valid for the types.
+func Field[T, V any](path string, p collections.Predicate[V]) collections.Predicate[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	fp := fieldPath.New[T, V](path)
+	return func(value T) bool {
+		v, ok := fp.Get(value)
+		return ok && p(v)
+	}
+}
+
 // Not negates the result of the given predicate.
 func Not[T any](p collections.Predicate[T]) collections.Predicate[T] {
 	return func(value T) bool {
@@ -253,3 +301,15 @@ func OnlyOne[T any](p ...collections.Predicate[T]) collections.Predicate[T] {
 		return found
 	}
 }
+
+// versionRange creates a predicate for the given parsed version constraint.
+// This will panic if the constraint failed to parse.
+func versionRange(c comp.VersionConstraint, err error) collections.Predicate[string] {
+	if err != nil {
+		panic(err)
+	}
+	return func(value string) bool {
+		ok, err := c.Satisfies(value)
+		return err == nil && ok
+	}
+}
@@ -211,6 +211,51 @@ func Test_Predicate_InRange(t *testing.T) {
 	checkPred(t, p, 8, false)
 }
 
+func Test_Predicate_SemVerRange(t *testing.T) {
+	p := SemVerRange(`>=1.2 <2.0`)
+	checkPred(t, p, `1.1.9`, false)
+	checkPred(t, p, `1.2.0`, true)
+	checkPred(t, p, `v1.10.3`, true)
+	checkPred(t, p, `2.0.0-rc.1`, false)
+	checkPred(t, p, `1.9.9-rc.1`, true)
+	checkPred(t, p, `2.0.0`, false)
+	checkPred(t, p, `1.5`, false)
+
+	p = SemVerRange(`<1 || = 1.4.2 || >= 3.0.0-beta`)
+	checkPred(t, p, `0.9.0`, true)
+	checkPred(t, p, `1.4.2+build.7`, true)
+	checkPred(t, p, `1.4.3`, false)
+	checkPred(t, p, `3.0.0-alpha`, false)
+	checkPred(t, p, `3.0.0-beta.2`, true)
+
+	checkPanic(t, func() {
+		SemVerRange(`>=1.2 ||`)
+	}, `invalid version constraint {constraint: >=1.2 ||, reason: a range may not be empty}`)
+	checkPanic(t, func() {
+		SemVerRange(`<`)
+	}, `invalid version constraint {constraint: <, reason: an operator must be followed by a version}`)
+	checkPanic(t, func() {
+		SemVerRange(`>=1.02`)
+	}, `invalid version constraint {constraint: >=1.02}: `+
+		`invalid semantic version {reason: a number may not have leading zeros, version: 1.02.0}`)
+}
+
+func Test_Predicate_DottedRange(t *testing.T) {
+	p := DottedRange(`>1.2.9 !=1.2.11 <=1.3`)
+	checkPred(t, p, `1.2.9`, false)
+	checkPred(t, p, `1.2.10`, true)
+	checkPred(t, p, `1.2.11`, false)
+	checkPred(t, p, `1.3`, true)
+	checkPred(t, p, `1.3.0`, true)
+	checkPred(t, p, `1.3.0.1`, false)
+	checkPred(t, p, `1.x`, false)
+
+	checkPanic(t, func() {
+		DottedRange(`>=1..2`)
+	}, `invalid version constraint {constraint: >=1..2}: `+
+		`invalid dotted identifier {identifier: 1..2, reason: a number may not be empty}`)
+}
+
 func Test_Predicate_EpsilonEq(t *testing.T) {
 	p1 := EpsilonEq(5.0, .2)
 	checkPred(t, p1, 4.0, false)
@@ -269,6 +314,48 @@ func Test_Predicate_Neg(t *testing.T) {
 	checkPred(t, p, -1.0e9, true)
 }
 
+func Test_Predicate_Field(t *testing.T) {
+	type address struct {
+		City string
+	}
+	type person struct {
+		Name   string
+		Home   *address
+		Tags   []string
+		Scores map[string]int
+	}
+	ada := person{
+		Name:   `Ada`,
+		Home:   &address{City: `London`},
+		Tags:   []string{`math`, `poetry`},
+		Scores: map[string]int{`math`: 98},
+	}
+	bob := person{Name: `Bob`}
+
+	p1 := Field[person](`Home.City`, Eq(`London`))
+	checkPred(t, p1, ada, true)
+	checkPred(t, p1, bob, false)
+
+	p2 := Field[*person](`Tags[1]`, Matches(`^po`))
+	checkPred(t, p2, &ada, true)
+	checkPred(t, p2, &bob, false)
+	checkPred(t, p2, nil, false)
+
+	p3 := Field[person](`Scores[math]`, GreaterThan(90))
+	checkPred(t, p3, ada, true)
+	checkPred(t, p3, bob, false)
+
+	checkPanic(t, func() {
+		Field[person](`Home.Town`, Eq(`London`))
+	}, `no field with the given name exists {part: Town, path: Home.Town, type: predicate.address}`)
+	checkPanic(t, func() {
+		Field[person](`Name`, Eq(42))
+	}, `the value at the end of the path is not the expected type {expected: int, path: Name, type: string}`)
+	checkPanic(t, func() {
+		Field[person, string](`Name`, nil)
+	}, `argument may not be nil {name: p}`)
+}
+
 func Test_Predicate_Not(t *testing.T) {
 	p := Not(Eq(5))
 	checkPred(t, p, 4, true)
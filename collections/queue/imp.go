@@ -9,6 +9,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyQueue"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -20,6 +21,8 @@ type (
 	}
 
 	queueImp[T any] struct {
+		collections.QueueKind
+
 		count     int
 		head      *node[T]
 		tail      *node[T]
@@ -235,9 +238,11 @@ func (q *queueImp[T]) Clip() {
 }
 
 func (q *queueImp[T]) Equals(other any) bool {
-	s, ok := other.(collections.Collection[T])
-	return ok && q.count == s.Count() &&
-		q.Enumerate().Equals(s.Enumerate())
+	return equality.Queue[T](q, other)
+}
+
+func (q *queueImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](q)
 }
 
 func (q *queueImp[T]) Clone() collections.Queue[T] {
@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/capQueue"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/list"
+	"github.com/Snow-Gremlin/goToolbox/collections/stack"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
 )
@@ -209,3 +211,21 @@ func Test_Queue_OnChange(t *testing.T) {
 	check.False(t).Assert(dequeue)
 	check.StringAndReset(t, ``).Assert(buf)
 }
+
+func Test_Queue_EqualsAcrossImplementations(t *testing.T) {
+	q1 := With(1, 2, 3)
+	q2 := capQueue.With(1, 2, 3)
+	check.True(t).Assert(q1.Equals(q2))
+	check.True(t).Assert(q2.Equals(q1))
+	check.True(t).Assert(q1.Readonly().Equals(q2.Readonly()))
+	check.Equal(t, q1.Hash()).Assert(q2.Hash())
+	check.Equal(t, q1.Hash()).Assert(q2.Readonly().Hash())
+
+	q2.Dequeue()
+	q2.Enqueue(1)
+	check.False(t).Assert(q1.Equals(q2))
+	check.NotEqual(t, q1.Hash()).Assert(q2.Hash())
+
+	check.False(t).Assert(q1.Equals(stack.With(1, 2, 3)))
+	check.False(t).Assert(q1.Equals(list.With(1, 2, 3)))
+}
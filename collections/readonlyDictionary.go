@@ -5,6 +5,10 @@ package collections
 //
 // The keys are unique. Depending on the implementation
 // the keys may be in sorted order or not.
+//
+// A dictionary is equal to any other `ReadonlyDictionary`, or any
+// `ReadonlyHashDictionary`, which has the same keys with equal values,
+// no matter how the dictionaries are implemented or the order of the keys.
 type ReadonlyDictionary[TKey comparable, TValue any] interface {
 	Collection[Tuple2[TKey, TValue]]
 	Container[TKey]
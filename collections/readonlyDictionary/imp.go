@@ -3,6 +3,7 @@ package readonlyDictionary
 import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 )
 
 type readonlyDictionaryImp[TKey comparable, TValue any] struct {
@@ -49,10 +50,18 @@ func (r readonlyDictionaryImp[TKey, TValue]) String() string {
 	return r.dic.String()
 }
 
+func (r readonlyDictionaryImp[TKey, TValue]) CustomEquality() bool {
+	return equality.IsCustom(r.dic)
+}
+
 func (r readonlyDictionaryImp[TKey, TValue]) Equals(other any) bool {
 	return r.dic.Equals(other)
 }
 
+func (r readonlyDictionaryImp[TKey, TValue]) Hash() uint64 {
+	return r.dic.Hash()
+}
+
 func (r readonlyDictionaryImp[TKey, TValue]) OnChange() events.Event[collections.ChangeArgs] {
 	return r.dic.OnChange()
 }
@@ -8,6 +8,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
+	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
@@ -77,6 +78,10 @@ func (d *pseudoDic[TKey, TValue]) Equals(other any) bool {
 	return ok && reflect.DeepEqual(d.ToMap(), d2.ToMap())
 }
 
+func (d *pseudoDic[TKey, TValue]) Hash() uint64 {
+	return comp.Hash(d.m)
+}
+
 func (d *pseudoDic[TKey, TValue]) OnChange() events.Event[collections.ChangeArgs] {
 	return d.e
 }
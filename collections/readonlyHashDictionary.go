@@ -0,0 +1,32 @@
+package collections
+
+// ReadonlyHashDictionary is the interface for key value pairs
+// which can not be directly modified and whose keys are
+// only required to be hashable, not `comparable`.
+//
+// This has all the methods of `ReadonlyDictionary` except `ToMap`,
+// since a Go map requires `comparable` keys. Every `ReadonlyDictionary`
+// is also a `ReadonlyHashDictionary`.
+//
+// A dictionary is equal to any other `ReadonlyHashDictionary`,
+// including any `ReadonlyDictionary`, which has the same keys with
+// equal values, no matter how the dictionaries are implemented
+// or the order of the keys.
+type ReadonlyHashDictionary[TKey, TValue any] interface {
+	Collection[Tuple2[TKey, TValue]]
+	Container[TKey]
+	Getter[TKey, TValue]
+	OnChanger
+
+	// Keys enumerates the keys.
+	//
+	// Depending on the type of dictionary these may
+	// be in random order or be sorted.
+	Keys() Enumerator[TKey]
+
+	// Values enumerates the values.
+	//
+	// Depending on the type of dictionary these may
+	// be in random order or ordered to match the sorted keys.
+	Values() Enumerator[TValue]
+}
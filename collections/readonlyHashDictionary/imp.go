@@ -0,0 +1,63 @@
+package readonlyHashDictionary
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
+)
+
+type readonlyHashDictionaryImp[TKey, TValue any] struct {
+	dic collections.ReadonlyHashDictionary[TKey, TValue]
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Get(key TKey) TValue {
+	return r.dic.Get(key)
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) TryGet(key TKey) (TValue, bool) {
+	return r.dic.TryGet(key)
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Enumerate() collections.Enumerator[collections.Tuple2[TKey, TValue]] {
+	return r.dic.Enumerate()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Keys() collections.Enumerator[TKey] {
+	return r.dic.Keys()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Values() collections.Enumerator[TValue] {
+	return r.dic.Values()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Empty() bool {
+	return r.dic.Empty()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Count() int {
+	return r.dic.Count()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Contains(key TKey) bool {
+	return r.dic.Contains(key)
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) String() string {
+	return r.dic.String()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) CustomEquality() bool {
+	return equality.IsCustom(r.dic)
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Equals(other any) bool {
+	return r.dic.Equals(other)
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) Hash() uint64 {
+	return r.dic.Hash()
+}
+
+func (r readonlyHashDictionaryImp[TKey, TValue]) OnChange() events.Event[collections.ChangeArgs] {
+	return r.dic.OnChange()
+}
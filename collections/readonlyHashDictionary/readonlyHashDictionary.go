@@ -0,0 +1,8 @@
+package readonlyHashDictionary
+
+import "github.com/Snow-Gremlin/goToolbox/collections"
+
+// New wraps another hash dictionary in a readonly shell.
+func New[TKey, TValue any](dic collections.ReadonlyHashDictionary[TKey, TValue]) collections.ReadonlyHashDictionary[TKey, TValue] {
+	return readonlyHashDictionaryImp[TKey, TValue]{dic: dic}
+}
@@ -0,0 +1,45 @@
+package readonlyHashDictionary
+
+import (
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/dictionary"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+func Test_HashDictionary_Readonly(t *testing.T) {
+	d1 := dictionary.New[int, int]()
+	r1 := New(d1)
+	check.Empty(t).Assert(r1)
+	check.True(t).Assert(r1.Empty())
+
+	d1.Add(123, 456)
+	check.Length(t, 1).Assert(r1)
+	check.False(t).Assert(r1.Empty())
+	check.Equal(t, 456).Assert(r1.Get(123))
+	check.True(t).Assert(r1.Contains(123))
+	check.False(t).Assert(r1.Contains(765))
+
+	d1.Add(222, 333)
+	check.Length(t, 2).Assert(r1)
+	check.Equal(t, 333).Assert(r1.Get(222))
+
+	v, ok := r1.TryGet(222)
+	check.Equal(t, 333).Assert(v)
+	check.True(t).Assert(ok)
+
+	v, ok = r1.TryGet(251)
+	check.Zero(t).Assert(v)
+	check.False(t).Assert(ok)
+
+	check.String(t, "123: 456\n222: 333").Assert(r1)
+	check.True(t).Name(`d1.Equals(r1)`).Assert(d1.Equals(r1))
+	check.True(t).Name(`r1.Equals(d1)`).Assert(r1.Equals(d1))
+	check.Equal(t, d1.Hash()).Assert(r1.Hash())
+
+	check.Equal(t, `[123, 456]|[222, 333]`).Assert(r1.Enumerate().Strings().Sort().Join(`|`))
+	check.Equal(t, `123|222`).Assert(r1.Keys().Sort().Join(`|`))
+	check.Equal(t, `333|456`).Assert(r1.Values().Sort().Join(`|`))
+
+	check.Same(t, d1.OnChange()).Assert(r1.OnChange())
+}
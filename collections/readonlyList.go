@@ -1,6 +1,9 @@
 package collections
 
 // ReadonlyList is a readonly linear collection of values.
+//
+// A list is equal to any other `ReadonlyList` which has equal values
+// in the same order, no matter how the lists are implemented.
 type ReadonlyList[T any] interface {
 	Collection[T]
 	Sliceable[T]
@@ -73,6 +73,10 @@ func (r readonlyListImp[T]) Equals(other any) bool {
 	return r.list.Equals(other)
 }
 
+func (r readonlyListImp[T]) Hash() uint64 {
+	return r.list.Hash()
+}
+
 func (r readonlyListImp[T]) OnChange() events.Event[collections.ChangeArgs] {
 	return r.list.OnChange()
 }
@@ -112,6 +112,10 @@ func (p *pseudoList[T]) Equals(other any) bool {
 	return true
 }
 
+func (p *pseudoList[T]) Hash() uint64 {
+	return comp.Hash(p.list)
+}
+
 func (p *pseudoList[T]) OnChange() events.Event[collections.ChangeArgs] {
 	return p.e
 }
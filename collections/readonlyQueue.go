@@ -1,6 +1,10 @@
 package collections
 
 // ReadonlyQueue is the readonly version of a queue.
+//
+// A queue is equal to any other `ReadonlyQueue` which has equal values
+// in the same order, no matter how the queues are implemented.
+// A queue is not equal to a stack which is marked with `StackKind`.
 type ReadonlyQueue[T any] interface {
 	Collection[T]
 	Sliceable[T]
@@ -8,3 +12,13 @@ type ReadonlyQueue[T any] interface {
 	Peeker[T]
 	OnChanger
 }
+
+// QueueKind is embedded into implementations of `ReadonlyQueue` to mark them
+// as queues. Since a queue has the same methods as a stack, a queue which
+// isn't marked may be equal to a stack with the same values.
+type QueueKind struct{}
+
+// IsQueue marks this as a queue, see `QueueKind`.
+func (QueueKind) IsQueue() bool {
+	return true
+}
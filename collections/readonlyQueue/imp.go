@@ -6,6 +6,7 @@ import (
 )
 
 type readonlyQueueImp[T any] struct {
+	collections.QueueKind
 	q collections.ReadonlyQueue[T]
 }
 
@@ -29,6 +30,10 @@ func (r readonlyQueueImp[T]) Equals(other any) bool {
 	return r.q.Equals(other)
 }
 
+func (r readonlyQueueImp[T]) Hash() uint64 {
+	return r.q.Hash()
+}
+
 func (r readonlyQueueImp[T]) ToSlice() []T {
 	return r.q.ToSlice()
 }
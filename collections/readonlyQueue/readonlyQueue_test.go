@@ -40,6 +40,10 @@ func (q *pseudoQueueImp[T]) Equals(other any) bool {
 	return ok && comp.Equal(q.ToSlice(), v.ToSlice())
 }
 
+func (q *pseudoQueueImp[T]) Hash() uint64 {
+	return comp.Hash(q.q)
+}
+
 func (q *pseudoQueueImp[T]) ToSlice() []T {
 	return slices.Clone(q.q)
 }
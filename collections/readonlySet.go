@@ -4,6 +4,9 @@ package collections
 //
 // For sets, the `ToSlice`, `ToList`, and `Enumerate` methods do not guarantee
 // any specific order and must be considered returning values in random order.
+//
+// A set is equal to any other `ReadonlySet` which has the same members,
+// no matter how the sets are implemented, e.g. a set and a sorted set.
 type ReadonlySet[T any] interface {
 	Collection[T]
 	Sliceable[T]
@@ -3,6 +3,7 @@ package readonlySet
 import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 )
 
 type readonlySetImp[T any] struct {
@@ -41,10 +42,18 @@ func (r readonlySetImp[T]) String() string {
 	return r.s.String()
 }
 
+func (r readonlySetImp[T]) CustomEquality() bool {
+	return equality.IsCustom(r.s)
+}
+
 func (r readonlySetImp[T]) Equals(other any) bool {
 	return r.s.Equals(other)
 }
 
+func (r readonlySetImp[T]) Hash() uint64 {
+	return r.s.Hash()
+}
+
 func (r readonlySetImp[T]) OnChange() events.Event[collections.ChangeArgs] {
 	return r.s.OnChange()
 }
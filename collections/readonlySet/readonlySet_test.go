@@ -8,6 +8,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/list"
+	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
 	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
@@ -74,6 +75,10 @@ func (s *pseudoSetImp) Equals(other any) bool {
 	return true
 }
 
+func (s *pseudoSetImp) Hash() uint64 {
+	return comp.Hash(s.m)
+}
+
 func (s *pseudoSetImp) OnChange() events.Event[collections.ChangeArgs] {
 	return s.e
 }
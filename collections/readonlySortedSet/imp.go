@@ -3,6 +3,7 @@ package readonlySortedSet
 import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/events"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 )
 
 type readonlySortedSetImp[T any] struct {
@@ -65,10 +66,18 @@ func (r readonlySortedSetImp[T]) String() string {
 	return r.s.String()
 }
 
+func (r readonlySortedSetImp[T]) CustomEquality() bool {
+	return equality.IsCustom(r.s)
+}
+
 func (r readonlySortedSetImp[T]) Equals(other any) bool {
 	return r.s.Equals(other)
 }
 
+func (r readonlySortedSetImp[T]) Hash() uint64 {
+	return r.s.Hash()
+}
+
 func (r readonlySortedSetImp[T]) OnChange() events.Event[collections.ChangeArgs] {
 	return r.s.OnChange()
 }
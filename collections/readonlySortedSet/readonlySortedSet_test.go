@@ -106,6 +106,10 @@ func (s *pseudoSortedSetImp) Equals(other any) bool {
 	return true
 }
 
+func (s *pseudoSortedSetImp) Hash() uint64 {
+	return comp.Hash(s.data)
+}
+
 func (s *pseudoSortedSetImp) OnChange() events.Event[collections.ChangeArgs] {
 	return s.e
 }
@@ -1,6 +1,10 @@
 package collections
 
 // ReadonlyStack is the readonly version of a stack.
+//
+// A stack is equal to any other `ReadonlyStack` which has equal values
+// in the same order, no matter how the stacks are implemented.
+// A stack is not equal to a queue which is marked with `QueueKind`.
 type ReadonlyStack[T any] interface {
 	Collection[T]
 	Sliceable[T]
@@ -8,3 +12,13 @@ type ReadonlyStack[T any] interface {
 	Peeker[T]
 	OnChanger
 }
+
+// StackKind is embedded into implementations of `ReadonlyStack` to mark them
+// as stacks. Since a stack has the same methods as a queue, a stack which
+// isn't marked may be equal to a queue with the same values.
+type StackKind struct{}
+
+// IsStack marks this as a stack, see `StackKind`.
+func (StackKind) IsStack() bool {
+	return true
+}
@@ -6,6 +6,7 @@ import (
 )
 
 type readonlyStackImp[T any] struct {
+	collections.StackKind
 	s collections.ReadonlyStack[T]
 }
 
@@ -29,6 +30,10 @@ func (r readonlyStackImp[T]) Equals(other any) bool {
 	return r.s.Equals(other)
 }
 
+func (r readonlyStackImp[T]) Hash() uint64 {
+	return r.s.Hash()
+}
+
 func (r readonlyStackImp[T]) ToSlice() []T {
 	return r.s.ToSlice()
 }
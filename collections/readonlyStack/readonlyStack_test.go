@@ -41,6 +41,10 @@ func (s *pseudoStackImp[T]) Equals(other any) bool {
 	return ok && comp.Equal(s.ToSlice(), v.ToSlice())
 }
 
+func (s *pseudoStackImp[T]) Hash() uint64 {
+	return comp.Hash(s.s)
+}
+
 func (s *pseudoStackImp[T]) ToSlice() []T {
 	return slices.Clone(s.s)
 }
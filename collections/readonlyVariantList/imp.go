@@ -7,6 +7,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/internal/optional"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
@@ -127,9 +128,12 @@ func (list *readonlyVariantListImp[T]) String() string {
 }
 
 func (list *readonlyVariantListImp[T]) Equals(other any) bool {
-	s, ok := other.(collections.Collection[T])
-	return ok && list.Count() == s.Count() &&
-		list.Enumerate().Equals(s.Enumerate())
+	s, ok := other.(collections.ReadonlyList[T])
+	return ok && equality.Sequence[T](list, s)
+}
+
+func (list *readonlyVariantListImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](list)
 }
 
 func (list *readonlyVariantListImp[T]) OnChange() events.Event[collections.ChangeArgs] {
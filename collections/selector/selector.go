@@ -0,0 +1,26 @@
+package selector
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/internal/fieldPath"
+)
+
+// Field is a selector which gets the value at the given path
+// inside of the value passed into the selector.
+//
+// The path is made of field names separated by dots, e.g. `Address.City`,
+// and indices or map keys in square brackets, e.g. `Tags[0]` or `Scores[math]`.
+// A map key may be quoted, e.g. `Scores["a.b"]`, to contain any characters.
+// Pointers along the path are followed. If the value at the end of the path
+// can not be reached, such as when a pointer is nil, an index is out-of-bounds,
+// or a map doesn't have a key, then this returns the zero value.
+//
+// The path is checked against the types when the selector is created.
+// This will panic if the path is malformed or isn't valid for the types.
+func Field[T, V any](path string) collections.Selector[T, V] {
+	fp := fieldPath.New[T, V](path)
+	return func(value T) V {
+		v, _ := fp.Get(value)
+		return v
+	}
+}
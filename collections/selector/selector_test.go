@@ -0,0 +1,48 @@
+package selector
+
+import (
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+type (
+	address struct {
+		City string
+	}
+
+	person struct {
+		Name   string
+		Home   *address
+		Tags   []string
+		Scores map[string]int
+	}
+)
+
+func Test_Selector_Field(t *testing.T) {
+	people := enumerator.Enumerate(
+		person{
+			Name:   `Ada`,
+			Home:   &address{City: `London`},
+			Tags:   []string{`math`, `poetry`},
+			Scores: map[string]int{`math`: 98},
+		},
+		person{
+			Name: `Bob`,
+			Tags: []string{`art`},
+		})
+
+	check.Equal(t, []string{`London`, ``}).Assert(enumerator.Select(people, Field[person, string](`Home.City`)).ToSlice())
+	check.Equal(t, []string{`math`, `art`}).Assert(enumerator.Select(people, Field[person, string](`Tags[0]`)).ToSlice())
+	check.Equal(t, []string{`poetry`, ``}).Assert(enumerator.Select(people, Field[person, string](`Tags[1]`)).ToSlice())
+	check.Equal(t, []int{98, 0}).Assert(enumerator.Select(people, Field[person, int](`Scores[math]`)).ToSlice())
+	check.Equal(t, `Ada`).Assert(Field[*person, string](`Name`)(&person{Name: `Ada`}))
+
+	check.MatchError(t, `^the field path is malformed \{path: Home\.\.City, position: 5, reason: expected a field name\}$`).Panic(func() {
+		Field[person, string](`Home..City`)
+	})
+	check.MatchError(t, `^the value at the end of the path is not the expected type \{expected: int, path: Tags\[0\], type: string\}$`).Panic(func() {
+		Field[person, int](`Tags[0]`)
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlySet"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
@@ -92,18 +93,12 @@ func (s *setImp[T]) String() string {
 }
 
 func (s *setImp[T]) Equals(other any) bool {
-	s2, ok := other.(collections.Collection[T])
-	if !ok || s.Count() != s2.Count() {
-		return false
-	}
+	s2, ok := other.(collections.ReadonlySet[T])
+	return ok && equality.Set[T](s, s2)
+}
 
-	it := s2.Enumerate().Iterate()
-	for it.Next() {
-		if !s.Contains(it.Current()) {
-			return false
-		}
-	}
-	return true
+func (s *setImp[T]) Hash() uint64 {
+	return equality.SetHash[T](s)
 }
 
 func (s *setImp[T]) OnChange() events.Event[collections.ChangeArgs] {
@@ -7,7 +7,9 @@ import (
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/list"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/sortedSet"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
 )
@@ -199,3 +201,25 @@ func Test_Set_OnChange(t *testing.T) {
 	s.Clear()
 	check.StringAndReset(t, ``).Assert(buf)
 }
+
+func Test_Set_EqualsAcrossImplementations(t *testing.T) {
+	s1 := With(3, 1, 2)
+	s2 := sortedSet.With([]int{1, 2, 3})
+	check.True(t).Assert(s1.Equals(s2))
+	check.True(t).Assert(s2.Equals(s1))
+	check.True(t).Assert(s1.Readonly().Equals(s2.Readonly()))
+	check.Equal(t, s1.Hash()).Assert(s2.Hash())
+	check.Equal(t, s1.Hash()).Assert(s2.Readonly().Hash())
+	check.Equal(t, s1.Hash()).Assert(With(2, 3, 1).Hash())
+
+	s2.Add(4)
+	check.False(t).Assert(s1.Equals(s2))
+	check.False(t).Assert(s2.Equals(s1))
+	check.NotEqual(t, s1.Hash()).Assert(s2.Hash())
+
+	// A set is not a list even when the values are in the same order.
+	l := list.With(s1.ToSlice()...)
+	check.False(t).Assert(s1.Equals(l))
+	check.False(t).Assert(l.Equals(s1))
+	check.False(t).Assert(s1.Equals(s1.ToList()))
+}
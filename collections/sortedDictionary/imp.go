@@ -16,6 +16,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/internal/simpleSet"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -34,6 +35,7 @@ type sortedDictionaryImp[TKey comparable, TValue any] struct {
 	keys     []TKey
 	comparer comp.Comparer[TKey]
 	event    events.Event[collections.ChangeArgs]
+	custom   bool
 }
 
 func (d *sortedDictionaryImp[TKey, TValue]) onChanged(cf changeFlag) bool {
@@ -195,6 +197,7 @@ func (d *sortedDictionaryImp[TKey, TValue]) Clone() collections.Dictionary[TKey,
 		keys:     slices.Clone(d.keys),
 		comparer: d.comparer,
 		event:    nil,
+		custom:   d.custom,
 	}
 }
 
@@ -316,19 +319,15 @@ func (d *sortedDictionaryImp[TKey, TValue]) String() string {
 	return buf.String()
 }
 
+func (d *sortedDictionaryImp[TKey, TValue]) CustomEquality() bool {
+	return d.custom
+}
+
 func (d *sortedDictionaryImp[TKey, TValue]) Equals(other any) bool {
-	d2, ok := other.(collections.Collection[collections.Tuple2[TKey, TValue]])
-	if !ok || d.Count() != d2.Count() {
-		return false
-	}
+	d2, ok := other.(collections.ReadonlyHashDictionary[TKey, TValue])
+	return ok && equality.Dictionary[TKey, TValue](d, d2)
+}
 
-	it := d2.Enumerate().Iterate()
-	for it.Next() {
-		key, value := it.Current().Values()
-		v2, ok := d.TryGet(key)
-		if !ok || !comp.Equal(v2, value) {
-			return false
-		}
-	}
-	return true
+func (d *sortedDictionaryImp[TKey, TValue]) Hash() uint64 {
+	return equality.DictionaryHash[TKey, TValue](d)
 }
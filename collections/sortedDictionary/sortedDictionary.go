@@ -11,6 +11,10 @@ import (
 
 // New creates a new dictionary with sorted keys by the
 // optional given comparer function or the default comparer.
+//
+// A dictionary with a given comparer is never equal to a dictionary without
+// a custom comparer or hasher, since the given comparer may not agree with
+// how the other dictionary compares keys.
 func New[TKey comparable, TValue any](comparer ...comp.Comparer[TKey]) collections.Dictionary[TKey, TValue] {
 	return CapNew[TKey, TValue](0, comparer...)
 }
@@ -25,6 +29,7 @@ func CapNew[TKey comparable, TValue any](capacity int, comparer ...comp.Comparer
 		keys:     make([]TKey, 0, capacity),
 		comparer: cmp,
 		event:    nil,
+		custom:   optional.Given(comparer),
 	}
 }
 
@@ -44,6 +49,7 @@ func With[TKey comparable, TValue any, M ~map[TKey]TValue](m M, comparer ...comp
 		keys:     utils.SortedKeys(m, cmp),
 		comparer: cmp,
 		event:    nil,
+		custom:   optional.Given(comparer),
 	}
 }
 
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/dictionary"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
@@ -325,3 +326,21 @@ func (c *pseudoComparable) CompareTo(other *pseudoComparable) int {
 	}
 	return strings.Compare(c.name, other.name)
 }
+
+func Test_SortedDictionary_EqualsWithCustomComparer(t *testing.T) {
+	d1 := With(map[string]int{`a`: 1, `b`: 2}, comp.Descender(comp.Ordered[string]()))
+	d2 := dictionary.With(map[string]int{`a`: 1, `b`: 2})
+	check.False(t).Assert(d1.Equals(d2))
+	check.False(t).Assert(d2.Equals(d1))
+	check.False(t).Assert(d2.Readonly().Equals(d1.Readonly()))
+
+	d3 := With(map[string]int{`a`: 1, `b`: 2})
+	check.True(t).Assert(d3.Equals(d2))
+	check.True(t).Assert(d2.Equals(d3))
+	check.Equal(t, d2.Hash()).Assert(d3.Hash())
+
+	d4 := With(map[string]int{`b`: 2, `a`: 1}, comp.Descender(comp.Ordered[string]()))
+	check.True(t).Assert(d1.Equals(d4))
+	check.True(t).Assert(d1.Readonly().Equals(d4.Readonly()))
+	check.Equal(t, d1.Hash()).Assert(d4.Hash())
+}
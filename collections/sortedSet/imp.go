@@ -13,6 +13,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -21,6 +22,7 @@ type sortedSetImp[T any] struct {
 	data     []T
 	comparer comp.Comparer[T]
 	event    events.Event[collections.ChangeArgs]
+	custom   bool
 }
 
 func (s *sortedSetImp[T]) find(value T) (int, bool) {
@@ -122,19 +124,17 @@ func (s *sortedSetImp[T]) String() string {
 	return strings.Join(parts, `, `)
 }
 
+func (s *sortedSetImp[T]) CustomEquality() bool {
+	return s.custom
+}
+
 func (s *sortedSetImp[T]) Equals(other any) bool {
-	s2, ok := other.(collections.Collection[T])
-	if !ok || s.Count() != s2.Count() {
-		return false
-	}
+	s2, ok := other.(collections.ReadonlySet[T])
+	return ok && equality.Set[T](s, s2)
+}
 
-	it := s2.Enumerate().Iterate()
-	for it.Next() {
-		if !s.Contains(it.Current()) {
-			return false
-		}
-	}
-	return true
+func (s *sortedSetImp[T]) Hash() uint64 {
+	return equality.SetHash[T](s)
 }
 
 func (s *sortedSetImp[T]) OnChange() events.Event[collections.ChangeArgs] {
@@ -364,6 +364,7 @@ func (s *sortedSetImp[T]) Clone() collections.SortedSet[T] {
 		data:     slices.Clone(s.data),
 		comparer: s.comparer,
 		event:    nil,
+		custom:   s.custom,
 	}
 }
 
@@ -14,6 +14,10 @@ import (
 // as well as the initial capacity. If a second integer argument is
 // provided it will specify a different capacity from the length.
 // The capacity will never be smaller than the list's length.
+//
+// A set with a given comparer is never equal to a set without a custom
+// comparer or hasher, since the given comparer may not agree with how
+// the other set compares values.
 func New[T any](comparer ...comp.Comparer[T]) collections.SortedSet[T] {
 	return CapNew(0, comparer...)
 }
@@ -27,6 +31,7 @@ func CapNew[T any](capacity int, comparer ...comp.Comparer[T]) collections.Sorte
 		data:     make([]T, 0, capacity),
 		comparer: cmp,
 		event:    nil,
+		custom:   optional.Given(comparer),
 	}
 }
 
@@ -10,6 +10,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/set"
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
 	"github.com/Snow-Gremlin/goToolbox/testers/check"
@@ -146,6 +147,23 @@ func Test_SortedSet_CustomCompare(t *testing.T) {
 	check.False(t).Assert(s2.Contains(52))
 }
 
+func Test_SortedSet_ByCompare(t *testing.T) {
+	type pet struct {
+		kind string
+		name string
+	}
+	s := New(comp.By(func(p pet) string { return p.kind }).
+		ThenBy(comp.By(func(p pet) string { return p.name })))
+	s.Add(pet{`dog`, `Rex`}, pet{`cat`, `Tom`}, pet{`dog`, `Ace`}, pet{`cat`, `Tom`})
+	check.Equal(t, []pet{{`cat`, `Tom`}, {`dog`, `Ace`}, {`dog`, `Rex`}}).Assert(s.ToSlice())
+}
+
+func Test_SortedSet_NaturalCompare(t *testing.T) {
+	s := New(comp.NaturalCaseInsensitive())
+	s.Add(`file10.txt`, `File2.txt`, `file1.txt`, `FILE1.txt`, `file02.txt`)
+	check.String(t, `file1.txt, File2.txt, file02.txt, file10.txt`).Assert(s)
+}
+
 func Test_SortedSet_New(t *testing.T) {
 	s := New[int]()
 	check.Empty(t).Assert(s)
@@ -346,3 +364,23 @@ func Test_SortedSet_Refresh(t *testing.T) {
 	check.StringAndReset(t, `Removed`).Assert(buf)
 	check.String(t, `&{Tod}`).Assert(s.String())
 }
+
+func Test_SortedSet_EqualsWithCustomComparer(t *testing.T) {
+	s1 := With([]string{`a`, `b`}, comp.CaseInsensitive())
+	s2 := set.With(`A`, `b`)
+	check.False(t).Assert(s1.Equals(s2))
+	check.False(t).Assert(s2.Equals(s1))
+	check.False(t).Assert(s1.Readonly().Equals(s2.Readonly()))
+	check.False(t).Assert(s2.Readonly().Equals(s1.Readonly()))
+
+	s3 := With([]string{`a`, `b`})
+	check.True(t).Assert(s3.Equals(set.With(`a`, `b`)))
+	check.True(t).Assert(set.With(`a`, `b`).Equals(s3))
+	check.Equal(t, set.With(`a`, `b`).Hash()).Assert(s3.Hash())
+	check.False(t).Assert(s1.Equals(s3))
+
+	s4 := With([]string{`b`, `a`}, comp.CaseInsensitive())
+	check.True(t).Assert(s1.Equals(s4))
+	check.True(t).Assert(s1.Readonly().Equals(s4.Clone()))
+	check.Equal(t, s1.Hash()).Assert(s4.Hash())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyStack"
 	"github.com/Snow-Gremlin/goToolbox/events"
 	"github.com/Snow-Gremlin/goToolbox/events/event"
+	"github.com/Snow-Gremlin/goToolbox/internal/equality"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -20,6 +21,8 @@ type (
 	}
 
 	stackImp[T any] struct {
+		collections.StackKind
+
 		count     int
 		head      *node[T]
 		enumGuard uint
@@ -237,9 +240,11 @@ func (s *stackImp[T]) Clip() {
 }
 
 func (s *stackImp[T]) Equals(other any) bool {
-	s2, ok := other.(collections.Collection[T])
-	return ok && s.count == s2.Count() &&
-		s.Enumerate().Equals(s2.Enumerate())
+	return equality.Stack[T](s, other)
+}
+
+func (s *stackImp[T]) Hash() uint64 {
+	return equality.SequenceHash[T](s)
 }
 
 func (s *stackImp[T]) Clone() collections.Stack[T] {
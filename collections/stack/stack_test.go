@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/capQueue"
+	"github.com/Snow-Gremlin/goToolbox/collections/capStack"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/list"
 	"github.com/Snow-Gremlin/goToolbox/events/listener"
@@ -223,3 +225,22 @@ func Test_Stack_OnChange(t *testing.T) {
 	check.StringAndReset(t, `Removed`).Assert(buf)
 	check.String(t, ``).Assert(s)
 }
+
+func Test_Stack_EqualsAcrossImplementations(t *testing.T) {
+	s1 := With(1, 2, 3)
+	s2 := capStack.With(1, 2, 3)
+	check.True(t).Assert(s1.Equals(s2))
+	check.True(t).Assert(s2.Equals(s1))
+	check.True(t).Assert(s1.Readonly().Equals(s2.Readonly()))
+	check.Equal(t, s1.Hash()).Assert(s2.Hash())
+	check.Equal(t, s1.Hash()).Assert(s2.Readonly().Hash())
+
+	s2.Pop()
+	check.False(t).Assert(s1.Equals(s2))
+	check.False(t).Assert(s2.Equals(s1))
+	check.NotEqual(t, s1.Hash()).Assert(s2.Hash())
+
+	// A queue has the same methods as a stack but is never equal to one.
+	check.False(t).Assert(s1.Equals(capQueue.With(1, 2, 3)))
+	check.False(t).Assert(s1.Readonly().Equals(capQueue.With(1, 2, 3).Readonly()))
+}
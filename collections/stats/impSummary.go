@@ -0,0 +1,117 @@
+package stats
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type summaryImp[T utils.NumConstraint] struct {
+	count int
+	sum   T
+	min   T
+	max   T
+	mean  float64
+	m2    float64
+}
+
+func newSummary[T utils.NumConstraint]() *summaryImp[T] {
+	return &summaryImp[T]{
+		count: 0,
+		sum:   utils.Zero[T](),
+		min:   utils.Zero[T](),
+		max:   utils.Zero[T](),
+		mean:  0.0,
+		m2:    0.0,
+	}
+}
+
+func (s *summaryImp[T]) Add(values ...T) {
+	for _, value := range values {
+		if s.count == 0 {
+			s.min, s.max = value, value
+		} else {
+			s.min = min(s.min, value)
+			s.max = max(s.max, value)
+		}
+		s.count++
+		s.sum += value
+
+		x := float64(value)
+		delta := x - s.mean
+		s.mean += delta / float64(s.count)
+		s.m2 += delta * (x - s.mean)
+	}
+}
+
+func (s *summaryImp[T]) Merge(other Summary[T]) {
+	count := other.Count()
+	if count <= 0 {
+		return
+	}
+	if s.count == 0 {
+		s.min, s.max = other.Min(), other.Max()
+	} else {
+		s.min = min(s.min, other.Min())
+		s.max = max(s.max, other.Max())
+	}
+
+	// Uses the parallel algorithm by Chan et al. to combine
+	// the means and sums of squared differences.
+	na, nb := float64(s.count), float64(count)
+	n := na + nb
+	delta := other.Mean() - s.mean
+	s.mean += delta * nb / n
+	s.m2 += other.Variance()*nb + delta*delta*na*nb/n
+	s.count += count
+	s.sum += other.Sum()
+}
+
+func (s *summaryImp[T]) Count() int {
+	return s.count
+}
+
+func (s *summaryImp[T]) Sum() T {
+	return s.sum
+}
+
+func (s *summaryImp[T]) Min() T {
+	return s.min
+}
+
+func (s *summaryImp[T]) Max() T {
+	return s.max
+}
+
+func (s *summaryImp[T]) Mean() float64 {
+	return s.mean
+}
+
+func (s *summaryImp[T]) Variance() float64 {
+	if s.count <= 0 {
+		return 0.0
+	}
+	return s.m2 / float64(s.count)
+}
+
+func (s *summaryImp[T]) SampleVariance() float64 {
+	if s.count <= 1 {
+		return 0.0
+	}
+	return s.m2 / float64(s.count-1)
+}
+
+func (s *summaryImp[T]) StdDev() float64 {
+	return math.Sqrt(s.Variance())
+}
+
+func (s *summaryImp[T]) Clone() Summary[T] {
+	c := *s
+	return &c
+}
+
+func (s *summaryImp[T]) String() string {
+	return fmt.Sprintf(`count: %d, sum: %v, min: %v, max: %v, mean: %g, std dev: %g`,
+		s.count, s.sum, s.min, s.max, s.mean, s.StdDev())
+}
@@ -0,0 +1,203 @@
+package stats
+
+import (
+	"math"
+	"slices"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Bucket is one range of values in a histogram.
+type Bucket struct {
+	// Low is the inclusive lower bound of the values in this bucket.
+	Low float64
+
+	// High is the upper bound of the values in this bucket.
+	// The bound is exclusive except for the last bucket in a histogram.
+	High float64
+
+	// Count is the number of values in this bucket.
+	Count int
+}
+
+// NewSummary creates a new empty summary.
+func NewSummary[T utils.NumConstraint]() Summary[T] {
+	return newSummary[T]()
+}
+
+// Summarize creates a summary of all the values in the given enumerator.
+func Summarize[T utils.NumConstraint](e collections.Enumerator[T]) Summary[T] {
+	s := newSummary[T]()
+	it := e.Iterate()
+	for it.Next() {
+		s.Add(it.Current())
+	}
+	return s
+}
+
+// Average gets the mean of all the values in the given enumerator.
+// Returns false if there are no values.
+func Average[T utils.NumConstraint](e collections.Enumerator[T]) (float64, bool) {
+	s := Summarize(e)
+	return s.Mean(), s.Count() > 0
+}
+
+// Variance gets the population variance of all the values in the given enumerator.
+// This uses the numerically stable Welford method.
+// Returns false if there are no values.
+func Variance[T utils.NumConstraint](e collections.Enumerator[T]) (float64, bool) {
+	s := Summarize(e)
+	return s.Variance(), s.Count() > 0
+}
+
+// StdDev gets the population standard deviation of all the values in the
+// given enumerator. This uses the numerically stable Welford method.
+// Returns false if there are no values.
+func StdDev[T utils.NumConstraint](e collections.Enumerator[T]) (float64, bool) {
+	s := Summarize(e)
+	return s.StdDev(), s.Count() > 0
+}
+
+// MinMax gets the minimum and maximum values from the given enumerator
+// in one pass. Returns false if there are no values.
+func MinMax[T utils.NumConstraint](e collections.Enumerator[T]) (T, T, bool) {
+	it := e.Iterate()
+	if !it.Next() {
+		return utils.Zero[T](), utils.Zero[T](), false
+	}
+	low := it.Current()
+	high := low
+	for it.Next() {
+		value := it.Current()
+		low = min(low, value)
+		high = max(high, value)
+	}
+	return low, high, true
+}
+
+// Median gets the middle value of all the values in the given enumerator.
+// If there are an even number of values, the mean of the two middle values is returned.
+// Returns false if there are no values.
+func Median[T utils.NumConstraint](e collections.Enumerator[T]) (float64, bool) {
+	return Percentile(e, 50.0)
+}
+
+// Percentile gets the given percentile, from 0 to 100 inclusively,
+// of all the values in the given enumerator. When the percentile falls
+// between two values, the result is linearly interpolated between them.
+// Returns false if there are no values.
+//
+// This will panic if the percentile is not between 0 and 100.
+func Percentile[T utils.NumConstraint](e collections.Enumerator[T], p float64) (float64, bool) {
+	if !(p >= 0.0 && p <= 100.0) {
+		panic(terror.New(`percentile must be between 0 and 100`).
+			With(`percentile`, p))
+	}
+
+	values := sorted(e)
+	count := len(values)
+	if count <= 0 {
+		return 0.0, false
+	}
+
+	rank := p / 100.0 * float64(count-1)
+	index := int(math.Floor(rank))
+	if index+1 >= count {
+		return values[count-1], true
+	}
+	frac := rank - float64(index)
+	return values[index] + (values[index+1]-values[index])*frac, true
+}
+
+// sorted reads all the values from the given enumerator as sorted floats.
+func sorted[T utils.NumConstraint](e collections.Enumerator[T]) []float64 {
+	values := []float64{}
+	it := e.Iterate()
+	for it.Next() {
+		values = append(values, float64(it.Current()))
+	}
+	slices.Sort(values)
+	return values
+}
+
+// Mode gets the most common value in the given enumerator and the number of
+// times that value occurs. If several values are the most common, the one which
+// occurs first is returned. Returns zero and a zero count if there are no values.
+func Mode[T utils.NumConstraint](e collections.Enumerator[T]) (T, int) {
+	counts := map[T]int{}
+	order := []T{}
+	it := e.Iterate()
+	for it.Next() {
+		value := it.Current()
+		if _, has := counts[value]; !has {
+			order = append(order, value)
+		}
+		counts[value]++
+	}
+
+	mode, best := utils.Zero[T](), 0
+	for _, value := range order {
+		if count := counts[value]; count > best {
+			mode, best = value, count
+		}
+	}
+	return mode, best
+}
+
+// Histogram counts the values in the given enumerator into the given number
+// of buckets of equal width spanning from the minimum to the maximum value.
+// Values which are NaN or infinite are skipped.
+// Returns an empty slice if there are no finite values.
+//
+// This will panic if the number of buckets is less than one.
+func Histogram[T utils.NumConstraint](e collections.Enumerator[T], buckets int) []Bucket {
+	if buckets <= 0 {
+		panic(terror.New(`must have at least one bucket in a histogram`).
+			With(`buckets`, buckets))
+	}
+
+	values := []float64{}
+	it := e.Iterate()
+	for it.Next() {
+		if value := float64(it.Current()); !math.IsNaN(value) && !math.IsInf(value, 0) {
+			values = append(values, value)
+		}
+	}
+	if len(values) <= 0 {
+		return []Bucket{}
+	}
+
+	low, high := values[0], values[0]
+	for _, value := range values[1:] {
+		low = min(low, value)
+		high = max(high, value)
+	}
+
+	width := (high - low) / float64(buckets)
+	if math.IsInf(width, 0) {
+		// The range of the values overflowed so divide the bounds first.
+		width = high/float64(buckets) - low/float64(buckets)
+	}
+	result := make([]Bucket, buckets)
+	for i := range result {
+		result[i] = Bucket{
+			Low:   low + width*float64(i),
+			High:  low + width*float64(i+1),
+			Count: 0,
+		}
+	}
+	result[buckets-1].High = high
+
+	for _, value := range values {
+		index := buckets - 1
+		if width > 0.0 {
+			if offset := (value - low) / width; offset < float64(buckets) {
+				index = max(int(offset), 0)
+			}
+		}
+		result[index].Count++
+	}
+	return result
+}
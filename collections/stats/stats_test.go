@@ -0,0 +1,153 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+func Test_Stats_Average(t *testing.T) {
+	avg, ok := Average(enumerator.Enumerate(2, 4, 4, 4, 5, 5, 7, 9))
+	check.True(t).Assert(ok)
+	check.Equal(t, 5.0).Assert(avg)
+
+	_, ok = Average(enumerator.Enumerate[int]())
+	check.False(t).Assert(ok)
+}
+
+func Test_Stats_VarianceAndStdDev(t *testing.T) {
+	e := enumerator.Enumerate(2, 4, 4, 4, 5, 5, 7, 9)
+	variance, ok := Variance(e)
+	check.True(t).Assert(ok)
+	check.Equal(t, 4.0).Assert(variance)
+
+	dev, ok := StdDev(e)
+	check.True(t).Assert(ok)
+	check.Equal(t, 2.0).Assert(dev)
+
+	// Large offsets would lose precision with the naive sum of squares method.
+	e2 := enumerator.Select(e, func(v int) float64 { return 1.0e9 + float64(v) })
+	variance, _ = Variance(e2)
+	check.Epsilon(t, 4.0, 1.0e-6).Assert(variance)
+
+	_, ok = StdDev(enumerator.Enumerate[float64]())
+	check.False(t).Assert(ok)
+}
+
+func Test_Stats_MinMax(t *testing.T) {
+	low, high, ok := MinMax(enumerator.Enumerate(3, -2, 8, 5))
+	check.True(t).Assert(ok)
+	check.Equal(t, -2).Assert(low)
+	check.Equal(t, 8).Assert(high)
+
+	_, _, ok = MinMax(enumerator.Enumerate[int]())
+	check.False(t).Assert(ok)
+}
+
+func Test_Stats_MedianAndPercentile(t *testing.T) {
+	median, ok := Median(enumerator.Enumerate(5, 1, 3))
+	check.True(t).Assert(ok)
+	check.Equal(t, 3.0).Assert(median)
+
+	median, _ = Median(enumerator.Enumerate(4, 1, 3, 2))
+	check.Equal(t, 2.5).Assert(median)
+
+	e := enumerator.Range(1, 5)
+	for p, exp := range map[float64]float64{0: 1, 25: 2, 50: 3, 90: 4.6, 100: 5} {
+		value, ok := Percentile(e, p)
+		check.True(t).Assert(ok)
+		check.Epsilon(t, exp, 1.0e-9).Name(`percentile`).With(`p`, p).Assert(value)
+	}
+
+	_, ok = Percentile(enumerator.Enumerate[int](), 50)
+	check.False(t).Assert(ok)
+
+	check.MatchError(t, `^percentile must be between 0 and 100 \{percentile: 101\}$`).Panic(func() {
+		Percentile(e, 101)
+	})
+	check.MatchError(t, `^percentile must be between 0 and 100 \{percentile: NaN\}$`).Panic(func() {
+		Percentile(e, math.NaN())
+	})
+}
+
+func Test_Stats_Mode(t *testing.T) {
+	mode, count := Mode(enumerator.Enumerate(3, 1, 2, 1, 3, 4))
+	check.Equal(t, 3).Assert(mode)
+	check.Equal(t, 2).Assert(count)
+
+	fMode, count := Mode(enumerator.Enumerate(1.5, 2.5, 2.5))
+	check.Equal(t, 2.5).Assert(fMode)
+	check.Equal(t, 2).Assert(count)
+
+	_, count = Mode(enumerator.Enumerate[int]())
+	check.Zero(t).Assert(count)
+}
+
+func Test_Stats_Histogram(t *testing.T) {
+	h := Histogram(enumerator.Enumerate(0, 1, 2, 3, 4, 5, 6, 7, 8, 10), 5)
+	check.Equal(t, []Bucket{
+		{Low: 0, High: 2, Count: 2},
+		{Low: 2, High: 4, Count: 2},
+		{Low: 4, High: 6, Count: 2},
+		{Low: 6, High: 8, Count: 2},
+		{Low: 8, High: 10, Count: 2},
+	}).Assert(h)
+
+	h = Histogram(enumerator.Repeat(3, 4), 2)
+	check.Equal(t, []Bucket{
+		{Low: 3, High: 3, Count: 0},
+		{Low: 3, High: 3, Count: 4},
+	}).Assert(h)
+
+	check.Empty(t).Assert(Histogram(enumerator.Enumerate[int](), 3))
+
+	// Values which aren't finite are skipped.
+	h = Histogram(enumerator.Enumerate(math.Inf(-1), 0, math.NaN(), 1, 4, math.Inf(1)), 2)
+	check.Equal(t, []Bucket{
+		{Low: 0, High: 2, Count: 2},
+		{Low: 2, High: 4, Count: 1},
+	}).Assert(h)
+	check.Empty(t).Assert(Histogram(enumerator.Enumerate(math.NaN(), math.Inf(1)), 3))
+
+	h = Histogram(enumerator.Enumerate(-math.MaxFloat64, 0, math.MaxFloat64), 2)
+	check.Equal(t, []Bucket{
+		{Low: -math.MaxFloat64, High: 0, Count: 1},
+		{Low: 0, High: math.MaxFloat64, Count: 2},
+	}).Assert(h)
+
+	check.MatchError(t, `^must have at least one bucket in a histogram \{buckets: 0\}$`).Panic(func() {
+		Histogram(enumerator.Range(0, 3), 0)
+	})
+}
+
+func Test_Stats_Summary(t *testing.T) {
+	s := NewSummary[int]()
+	check.Equal(t, `count: 0, sum: 0, min: 0, max: 0, mean: 0, std dev: 0`).Assert(s.String())
+	check.Zero(t).Assert(s.SampleVariance())
+
+	s.Add(2, 4, 4, 4)
+	other := Summarize(enumerator.Enumerate(5, 5, 7, 9))
+	s.Merge(other)
+	s.Merge(NewSummary[int]())
+	check.Equal(t, 8).Assert(s.Count())
+	check.Equal(t, 40).Assert(s.Sum())
+	check.Equal(t, 2).Assert(s.Min())
+	check.Equal(t, 9).Assert(s.Max())
+	check.Equal(t, 5.0).Assert(s.Mean())
+	check.Epsilon(t, 4.0, 1.0e-9).Assert(s.Variance())
+	check.Epsilon(t, 32.0/7.0, 1.0e-9).Assert(s.SampleVariance())
+	check.Equal(t, `count: 8, sum: 40, min: 2, max: 9, mean: 5, std dev: 2`).Assert(s.String())
+
+	empty := NewSummary[int]()
+	empty.Merge(other)
+	check.Equal(t, 5).Assert(empty.Min())
+	check.Equal(t, 9).Assert(empty.Max())
+
+	c := s.Clone()
+	c.Add(100)
+	check.Equal(t, 8).Assert(s.Count())
+	check.Equal(t, 9).Assert(c.Count())
+	check.Equal(t, 100).Assert(c.Max())
+}
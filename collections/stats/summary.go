@@ -0,0 +1,53 @@
+package stats
+
+import "github.com/Snow-Gremlin/goToolbox/utils"
+
+// Summary is a streaming summary of numerical values.
+//
+// Values can be added one at a time without keeping the values,
+// and summaries of separate chunks of values can be merged together.
+// The mean and variance are calculated with the numerically stable
+// Welford method so that large values do not lose precision.
+type Summary[T utils.NumConstraint] interface {
+	// Add adds the given values to the summary.
+	Add(values ...T)
+
+	// Merge adds all the values summarized by the other summary to this summary.
+	Merge(other Summary[T])
+
+	// Count is the number of values which have been added.
+	Count() int
+
+	// Sum is the sum of all the values which have been added.
+	Sum() T
+
+	// Min is the minimum value which has been added,
+	// or zero if no values have been added.
+	Min() T
+
+	// Max is the maximum value which has been added,
+	// or zero if no values have been added.
+	Max() T
+
+	// Mean is the average of the values which have been added,
+	// or zero if no values have been added.
+	Mean() float64
+
+	// Variance is the population variance of the values which have been added,
+	// or zero if no values have been added.
+	Variance() float64
+
+	// SampleVariance is the sample variance of the values which have been added,
+	// or zero if less than two values have been added.
+	SampleVariance() float64
+
+	// StdDev is the population standard deviation of the values which have
+	// been added, or zero if no values have been added.
+	StdDev() float64
+
+	// Clone creates a copy of this summary.
+	Clone() Summary[T]
+
+	// String gets a human readable string for this summary.
+	String() string
+}
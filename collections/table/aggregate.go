@@ -0,0 +1,106 @@
+package table
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/stats"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Aggregate is a column of a grouped table which has a value reduced
+// from all the rows in each group, see `GroupBy` on a table.
+type Aggregate interface {
+	Header
+
+	// aggregate reduces the rows in one group into the value for this column.
+	aggregate(rows collections.Enumerator[Row]) any
+}
+
+type aggregateImp[T any] struct {
+	Column[T]
+	reduce func(rows collections.Enumerator[Row]) T
+}
+
+func (a aggregateImp[T]) aggregate(rows collections.Enumerator[Row]) any {
+	return a.reduce(rows)
+}
+
+// NewAggregate creates an aggregate column with the given name
+// which has the value returned by the given reducer for the rows in each group.
+func NewAggregate[T any](name string, reduce func(rows collections.Enumerator[Row]) T) Aggregate {
+	if utils.IsNil(reduce) {
+		panic(terror.NilArg(`reduce`))
+	}
+	return aggregateImp[T]{
+		Column: Col[T](name),
+		reduce: reduce,
+	}
+}
+
+// Count creates an aggregate column with the given name
+// which has the number of rows in each group.
+func Count(name string) Aggregate {
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) int {
+		return rows.Count()
+	})
+}
+
+// Sum creates an aggregate column with the given name
+// which has the sum of the values in the given column for each group.
+func Sum[T utils.NumConstraint](name string, column Column[T]) Aggregate {
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) T {
+		sum, _ := enumerator.Sum(enumerator.Select(rows, column.Get))
+		return sum
+	})
+}
+
+// Average creates an aggregate column with the given name
+// which has the mean of the values in the given column for each group.
+func Average[T utils.NumConstraint](name string, column Column[T]) Aggregate {
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) float64 {
+		avg, _ := stats.Average(enumerator.Select(rows, column.Get))
+		return avg
+	})
+}
+
+// Min creates an aggregate column with the given name which has the
+// smallest of the values in the given column for each group.
+// This will panic if the given column has no comparer.
+func Min[T any](name string, column Column[T]) Aggregate {
+	cmp := column.comparer()
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) T {
+		return enumerator.Select(rows, column.Get).Min(cmp)
+	})
+}
+
+// Max creates an aggregate column with the given name which has the
+// largest of the values in the given column for each group.
+// This will panic if the given column has no comparer.
+func Max[T any](name string, column Column[T]) Aggregate {
+	cmp := column.comparer()
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) T {
+		return enumerator.Select(rows, column.Get).Max(cmp)
+	})
+}
+
+// Collect creates an aggregate column with the given name which has
+// a slice of all the values in the given column for each group.
+func Collect[T any](name string, column Column[T]) Aggregate {
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) []T {
+		return enumerator.Select(rows, column.Get).ToSlice()
+	})
+}
+
+// Reduce creates an aggregate column with the given name which has
+// the values in the given column for each group reduced into one value.
+// The reducer is called with the prior returned value from the previous call.
+// The first call for each group is given the initial value.
+func Reduce[T, TOut any](name string, column Column[T], init TOut, reducer collections.Reducer[T, TOut]) Aggregate {
+	if utils.IsNil(reducer) {
+		panic(terror.NilArg(`reducer`))
+	}
+	return NewAggregate(name, func(rows collections.Enumerator[Row]) TOut {
+		return enumerator.Reduce(enumerator.Select(rows, column.Get), init, reducer)
+	})
+}
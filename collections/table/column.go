@@ -0,0 +1,189 @@
+package table
+
+import (
+	"reflect"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Header describes one column of a table,
+// the name of the column and the type of the values in it.
+// Use `Col` to create a typed column which is a header.
+type Header interface {
+	// Name is the name of the column.
+	Name() string
+
+	// Type is the type of the values in the column.
+	Type() reflect.Type
+
+	// newData creates new empty storage for the values of the column.
+	newData(capacity int) columnData
+}
+
+// Column is a typed header for a column of a table.
+// It is used to define the columns of a new table and
+// to read the values out of the rows of a table with their type.
+type Column[T any] struct {
+	name string
+	cmp  comp.Comparer[T]
+}
+
+// Col creates a typed column with the given name.
+//
+// This can take an optional comparer to use when sorting by this column
+// or finding the minimum and maximum values in this column.
+// If no comparer is given then the default comparer for the type is used,
+// if the type has a default comparer.
+func Col[T any](name string, comparer ...comp.Comparer[T]) Column[T] {
+	if len(name) <= 0 {
+		panic(terror.New(`a column name may not be empty`))
+	}
+	if count := len(comparer); count > 1 {
+		panic(terror.InvalidArgCount(1, count, `comparer`))
+	}
+	var cmp comp.Comparer[T]
+	if len(comparer) > 0 && !utils.IsNil(comparer[0]) {
+		cmp = comparer[0]
+	} else {
+		cmp = comp.Default[T]()
+	}
+	return Column[T]{
+		name: name,
+		cmp:  cmp,
+	}
+}
+
+// Name is the name of the column.
+func (c Column[T]) Name() string {
+	return c.name
+}
+
+// Type is the type of the values in the column.
+func (c Column[T]) Type() reflect.Type {
+	return utils.TypeOf[T]()
+}
+
+func (c Column[T]) newData(capacity int) columnData {
+	return newColumnData(c, capacity)
+}
+
+// comparer gets the comparer for this column's values.
+// This will panic if the column has no comparer.
+func (c Column[T]) comparer() comp.Comparer[T] {
+	if utils.IsNil(c.cmp) {
+		panic(noComparer(c))
+	}
+	return c.cmp
+}
+
+// Get gets the value of this column from the given row.
+// This will panic if the row's table doesn't have this column
+// or if the column in the row's table has a different type.
+func (c Column[T]) Get(row Row) T {
+	if value, ok := c.TryGet(row); ok {
+		return value
+	}
+	if !row.Table().HasColumn(c.name) {
+		panic(unknownColumn(c.name))
+	}
+	panic(terror.New(`the column in the table is not the expected type`).
+		With(`column`, c.name).
+		With(`expected`, c.Type()).
+		With(`actual`, row.Table().Header(c.name).Type()))
+}
+
+// TryGet gets the value of this column from the given row.
+// Returns false if the row's table doesn't have this column
+// or if the column in the row's table has a different type.
+func (c Column[T]) TryGet(row Row) (T, bool) {
+	if r, ok := row.(rowImp); ok {
+		if index, has := r.t.names[c.name]; has {
+			if data, ok := r.t.data[index].(*columnImp[T]); ok {
+				return data.values.Get(r.index), true
+			}
+		}
+		return utils.Zero[T](), false
+	}
+
+	if value, has := row.TryValue(c.name); has {
+		if v, ok := value.(T); ok {
+			return v, true
+		}
+		if value == nil && row.Table().Header(c.name).Type() == c.Type() {
+			return utils.Zero[T](), true
+		}
+	}
+	return utils.Zero[T](), false
+}
+
+// Values enumerates all the values of this column in the given table.
+// This will panic if the table doesn't have this column
+// or if the column in the table has a different type.
+func (c Column[T]) Values(t Table) collections.Enumerator[T] {
+	return enumerator.Select(t.Enumerate(), c.Get)
+}
+
+// Is creates a predicate for rows which is true when
+// the value of this column in the row satisfies the given predicate.
+func (c Column[T]) Is(p collections.Predicate[T]) collections.Predicate[Row] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	return func(row Row) bool {
+		return p(c.Get(row))
+	}
+}
+
+// Ascending creates a comparer for rows which compares the values
+// of this column from smallest to largest using the column's comparer.
+// This will panic if the column has no comparer.
+func (c Column[T]) Ascending() comp.Comparer[Row] {
+	cmp := c.comparer()
+	return func(x, y Row) int {
+		return cmp(c.Get(x), c.Get(y))
+	}
+}
+
+// Descending creates a comparer for rows which compares the values
+// of this column from largest to smallest using the column's comparer.
+// This will panic if the column has no comparer.
+func (c Column[T]) Descending() comp.Comparer[Row] {
+	return c.Ascending().Reverse()
+}
+
+// Computed is a new column which has a value computed
+// from each row of a table, see `Extend` on a table.
+type Computed interface {
+	Header
+
+	// compute gets the value of this column for the given row.
+	compute(row Row) any
+}
+
+type computedImp[T any] struct {
+	Column[T]
+	selector collections.Selector[Row, T]
+}
+
+func (c computedImp[T]) compute(row Row) any {
+	return c.selector(row)
+}
+
+// Compute creates a computed column with the given name which has the value
+// returned by the given selector for each row of the table being extended.
+//
+// This can take an optional comparer to use when sorting by this column
+// or finding the minimum and maximum values in this column.
+func Compute[T any](name string, selector collections.Selector[Row, T], comparer ...comp.Comparer[T]) Computed {
+	if utils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	return computedImp[T]{
+		Column:   Col(name, comparer...),
+		selector: selector,
+	}
+}
@@ -0,0 +1,488 @@
+package table
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type tableImp struct {
+	data  []columnData
+	names map[string]int
+	count int
+}
+
+func newTable(headers []Header, capacity int) *tableImp {
+	data := make([]columnData, len(headers))
+	for i, h := range headers {
+		if utils.IsNil(h) {
+			panic(terror.NilArg(`header`).
+				With(`index`, i))
+		}
+		data[i] = h.newData(capacity)
+	}
+	return newTableFrom(data, 0)
+}
+
+func newTableFrom(data []columnData, count int) *tableImp {
+	names := make(map[string]int, len(data))
+	for i, d := range data {
+		name := d.header().Name()
+		if _, exists := names[name]; exists {
+			panic(terror.New(`the column names in a table must be unique`).
+				With(`column`, name))
+		}
+		names[name] = i
+	}
+	return &tableImp{
+		data:  data,
+		names: names,
+		count: count,
+	}
+}
+
+func unknownColumn(column string) terrors.TError {
+	return terror.New(`no column with the given name exists in the table`).
+		With(`column`, column)
+}
+
+func noComparer(h Header) terrors.TError {
+	return terror.New(`must provide a comparer to compare the values of this column`).
+		With(`column`, h.Name()).
+		With(`type`, h.Type())
+}
+
+func wrongType(h Header, value any) terrors.TError {
+	return terror.New(`the value is not the type of the column`).
+		With(`column`, h.Name()).
+		With(`type`, h.Type()).
+		WithType(`value type`, value)
+}
+
+// indexOf gets the index of the column with the given name.
+// This will panic if there is no column with the given name.
+func (t *tableImp) indexOf(column string) int {
+	index, ok := t.names[column]
+	if !ok {
+		panic(unknownColumn(column))
+	}
+	return index
+}
+
+// keyColumns gets the indices of the given columns to use as keys.
+// This will panic if any column doesn't exist or its values aren't comparable.
+func (t *tableImp) keyColumns(columns []string) []int {
+	indices := make([]int, len(columns))
+	for i, column := range columns {
+		indices[i] = t.indexOf(column)
+		if h := t.data[indices[i]].header(); !strictlyComparable(h.Type()) {
+			panic(terror.New(`the values of a key column must be comparable`).
+				With(`column`, column).
+				With(`type`, h.Type()))
+		}
+	}
+	return indices
+}
+
+// strictlyComparable determines if all values of the given type can be
+// compared. Interfaces are comparable but will panic when comparing values
+// which aren't, e.g. an `any` holding a slice, so they are not allowed,
+// even when they are within an array or struct.
+func strictlyComparable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Interface:
+		return false
+	case reflect.Array:
+		return strictlyComparable(t.Elem())
+	case reflect.Struct:
+		for i := range t.NumField() {
+			if !strictlyComparable(t.Field(i).Type) {
+				return false
+			}
+		}
+		return true
+	default:
+		return t.Comparable()
+	}
+}
+
+// key is a comparable composite of the values in the key columns of a row.
+type key struct {
+	prior any
+	value any
+}
+
+// keyOf gets the composite key for the values in the given columns
+// for the row at the given index.
+func (t *tableImp) keyOf(columns []int, index int) any {
+	var k any
+	for _, column := range columns {
+		k = key{
+			prior: k,
+			value: t.data[column].get(index),
+		}
+	}
+	return k
+}
+
+// allRows gets the indices for all the rows in the table.
+func (t *tableImp) allRows() []int {
+	rows := make([]int, t.count)
+	for i := range rows {
+		rows[i] = i
+	}
+	return rows
+}
+
+// subset creates a new table with the rows at the given indices.
+func (t *tableImp) subset(rows []int) *tableImp {
+	data := make([]columnData, len(t.data))
+	for i, d := range t.data {
+		data[i] = d.subset(rows)
+	}
+	return newTableFrom(data, len(rows))
+}
+
+func (t *tableImp) row(index int) Row {
+	return rowImp{
+		t:     t,
+		index: index,
+	}
+}
+
+// rowsOf enumerates the rows at the given indices.
+func (t *tableImp) rowsOf(rows []int) collections.Enumerator[Row] {
+	return enumerator.Select(enumerator.Enumerate(rows...), t.row)
+}
+
+func (t *tableImp) Count() int {
+	return t.count
+}
+
+func (t *tableImp) Empty() bool {
+	return t.count <= 0
+}
+
+func (t *tableImp) Enumerate() collections.Enumerator[Row] {
+	return enumerator.New(func() collections.Iterator[Row] {
+		return iterator.Select(iterator.Range(0, t.count), t.row)
+	})
+}
+
+func (t *tableImp) Columns() []string {
+	names := make([]string, len(t.data))
+	for i, d := range t.data {
+		names[i] = d.header().Name()
+	}
+	return names
+}
+
+func (t *tableImp) Headers() []Header {
+	headers := make([]Header, len(t.data))
+	for i, d := range t.data {
+		headers[i] = d.header()
+	}
+	return headers
+}
+
+func (t *tableImp) Header(column string) Header {
+	return t.data[t.indexOf(column)].header()
+}
+
+func (t *tableImp) HasColumn(column string) bool {
+	_, ok := t.names[column]
+	return ok
+}
+
+func (t *tableImp) Row(index int) Row {
+	if index < 0 || index >= t.count {
+		panic(terror.OutOfBounds(index, t.count))
+	}
+	return t.row(index)
+}
+
+func (t *tableImp) AddRow(values ...any) {
+	if len(values) != len(t.data) {
+		panic(terror.New(`the number of values must match the number of columns`).
+			With(`columns`, len(t.data)).
+			With(`values`, len(values)))
+	}
+	for i, d := range t.data {
+		if h := d.header(); !assignable(h, values[i]) {
+			panic(wrongType(h, values[i]))
+		}
+	}
+	for i, d := range t.data {
+		d.add(values[i])
+	}
+	t.count++
+}
+
+func (t *tableImp) AddMap(values map[string]any) {
+	for column := range values {
+		t.indexOf(column)
+	}
+	row := make([]any, len(t.data))
+	for i, d := range t.data {
+		if value, ok := values[d.header().Name()]; ok {
+			row[i] = value
+		} else {
+			row[i] = reflect.Zero(d.header().Type()).Interface()
+		}
+	}
+	t.AddRow(row...)
+}
+
+// assignable determines if the given value can be added to the column.
+func assignable(h Header, value any) bool {
+	typ := h.Type()
+	if value == nil {
+		switch typ.Kind() {
+		case reflect.Chan, reflect.Func, reflect.Interface,
+			reflect.Map, reflect.Pointer, reflect.Slice:
+			return true
+		default:
+			return false
+		}
+	}
+	valueType := reflect.TypeOf(value)
+	if typ.Kind() == reflect.Interface {
+		return valueType.Implements(typ)
+	}
+	return valueType == typ
+}
+
+func (t *tableImp) Maps() collections.Enumerator[map[string]any] {
+	return enumerator.Select(t.Enumerate(), Row.ToMap)
+}
+
+func (t *tableImp) Where(p collections.Predicate[Row]) Table {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	rows := []int{}
+	for i := range t.count {
+		if p(t.row(i)) {
+			rows = append(rows, i)
+		}
+	}
+	return t.subset(rows)
+}
+
+func (t *tableImp) Select(columns ...string) Table {
+	rows := t.allRows()
+	data := make([]columnData, len(columns))
+	for i, column := range columns {
+		data[i] = t.data[t.indexOf(column)].subset(rows)
+	}
+	return newTableFrom(data, t.count)
+}
+
+func (t *tableImp) Extend(columns ...Computed) Table {
+	headers := t.Headers()
+	for i, column := range columns {
+		if utils.IsNil(column) {
+			panic(terror.NilArg(`column`).
+				With(`index`, i))
+		}
+		headers = append(headers, column)
+	}
+
+	result := newTable(headers, t.count)
+	for i := range t.count {
+		row := t.row(i)
+		for j, d := range t.data {
+			result.data[j].addFrom(d, i)
+		}
+		for j, column := range columns {
+			d := result.data[len(t.data)+j]
+			if value := column.compute(row); !d.add(value) {
+				panic(wrongType(column, value))
+			}
+		}
+	}
+	result.count = t.count
+	return result
+}
+
+func (t *tableImp) Sort(comparers ...comp.Comparer[Row]) Table {
+	for i, cmp := range comparers {
+		if utils.IsNil(cmp) {
+			panic(terror.NilArg(`comparer`).
+				With(`index`, i))
+		}
+	}
+	rows := t.allRows()
+	slices.SortStableFunc(rows, func(i, j int) int {
+		x, y := t.row(i), t.row(j)
+		for _, cmp := range comparers {
+			if result := cmp(x, y); result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+	return t.subset(rows)
+}
+
+func (t *tableImp) SortBy(columns ...string) Table {
+	cmps := make([]func(i, j int) int, len(columns))
+	for i, column := range columns {
+		d := t.data[t.indexOf(column)]
+		cmps[i] = d.comparer()
+	}
+	rows := t.allRows()
+	slices.SortStableFunc(rows, func(i, j int) int {
+		for _, cmp := range cmps {
+			if result := cmp(i, j); result != 0 {
+				return result
+			}
+		}
+		return 0
+	})
+	return t.subset(rows)
+}
+
+func (t *tableImp) GroupBy(keys []string, aggregates ...Aggregate) Table {
+	columns := t.keyColumns(keys)
+	headers := make([]Header, 0, len(columns)+len(aggregates))
+	for _, column := range columns {
+		headers = append(headers, t.data[column].header())
+	}
+	for i, agg := range aggregates {
+		if utils.IsNil(agg) {
+			panic(terror.NilArg(`aggregate`).
+				With(`index`, i))
+		}
+		headers = append(headers, agg)
+	}
+
+	groupIndex := map[any]int{}
+	groups := [][]int{}
+	for i := range t.count {
+		k := t.keyOf(columns, i)
+		if index, ok := groupIndex[k]; ok {
+			groups[index] = append(groups[index], i)
+			continue
+		}
+		groupIndex[k] = len(groups)
+		groups = append(groups, []int{i})
+	}
+
+	result := newTable(headers, len(groups))
+	for _, group := range groups {
+		for i, column := range columns {
+			result.data[i].addFrom(t.data[column], group[0])
+		}
+		for i, agg := range aggregates {
+			d := result.data[len(columns)+i]
+			if value := agg.aggregate(t.rowsOf(group)); !d.add(value) {
+				panic(wrongType(agg, value))
+			}
+		}
+	}
+	result.count = len(groups)
+	return result
+}
+
+func (t *tableImp) Join(other Table, on ...string) Table {
+	return t.join(other, on, false)
+}
+
+func (t *tableImp) LeftJoin(other Table, on ...string) Table {
+	return t.join(other, on, true)
+}
+
+// join performs a join of this table with the other table.
+// If leftOuter is true, the rows of this table without a match
+// are kept and given zero values for the other table's columns.
+func (t *tableImp) join(other Table, on []string, leftOuter bool) Table {
+	right := asImp(other)
+	leftKeys := t.keyColumns(on)
+	rightKeys := right.keyColumns(on)
+	for i, column := range on {
+		lt := t.data[leftKeys[i]].header().Type()
+		rt := right.data[rightKeys[i]].header().Type()
+		if lt != rt {
+			panic(terror.New(`the columns being joined on must have the same type in both tables`).
+				With(`column`, column).
+				With(`left type`, lt).
+				With(`right type`, rt))
+		}
+	}
+
+	rightColumns := []int{}
+	for i := range right.data {
+		if !slices.Contains(rightKeys, i) {
+			rightColumns = append(rightColumns, i)
+		}
+	}
+
+	headers := t.Headers()
+	for _, column := range rightColumns {
+		headers = append(headers, right.data[column].header())
+	}
+	result := newTable(headers, t.count)
+
+	lookup := map[any][]int{}
+	for i := range right.count {
+		k := right.keyOf(rightKeys, i)
+		lookup[k] = append(lookup[k], i)
+	}
+
+	leftCount := len(t.data)
+	for i := range t.count {
+		matches := lookup[t.keyOf(leftKeys, i)]
+		if len(matches) <= 0 && !leftOuter {
+			continue
+		}
+		for j, d := range t.data {
+			for range max(len(matches), 1) {
+				result.data[j].addFrom(d, i)
+			}
+		}
+		for j, column := range rightColumns {
+			d := result.data[leftCount+j]
+			if len(matches) <= 0 {
+				d.addZero()
+				continue
+			}
+			for _, match := range matches {
+				d.addFrom(right.data[column], match)
+			}
+		}
+		result.count += max(len(matches), 1)
+	}
+	return result
+}
+
+// asImp gets the given table as a table implementation,
+// copying the table if it is a different implementation.
+func asImp(t Table) *tableImp {
+	if utils.IsNil(t) {
+		panic(terror.NilArg(`other`))
+	}
+	if imp, ok := t.(*tableImp); ok {
+		return imp
+	}
+	result := newTable(t.Headers(), t.Count())
+	t.Enumerate().Foreach(func(row Row) {
+		result.AddRow(row.ToSlice()...)
+	})
+	return result
+}
+
+func (t *tableImp) Clone() Table {
+	return t.subset(t.allRows())
+}
+
+func (t *tableImp) String() string {
+	return format(t)
+}
@@ -0,0 +1,90 @@
+package table
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/list"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// columnData is the storage for the values of one column in a table.
+type columnData interface {
+	// header gets the header for this column.
+	header() Header
+
+	// get gets the value at the given row index.
+	get(index int) any
+
+	// add appends the given value to the end of the column.
+	// Returns false if the value isn't the type of the column.
+	add(value any) bool
+
+	// addZero appends the zero value to the end of the column.
+	addZero()
+
+	// addFrom appends the value at the given row index
+	// from the given column data of the same type.
+	addFrom(other columnData, index int)
+
+	// subset creates new data with the values from the given row indices.
+	subset(rows []int) columnData
+
+	// comparer gets a comparer for the values at two row indices.
+	// This will panic if the column has no comparer.
+	comparer() func(i, j int) int
+}
+
+// columnImp is the storage for the values of a column with the given type.
+type columnImp[T any] struct {
+	col    Column[T]
+	values collections.List[T]
+}
+
+func newColumnData[T any](col Column[T], capacity int) *columnImp[T] {
+	return &columnImp[T]{
+		col:    col,
+		values: list.New[T](0, capacity),
+	}
+}
+
+func (c *columnImp[T]) header() Header {
+	return c.col
+}
+
+func (c *columnImp[T]) get(index int) any {
+	return c.values.Get(index)
+}
+
+func (c *columnImp[T]) add(value any) bool {
+	if v, ok := value.(T); ok {
+		c.values.Append(v)
+		return true
+	}
+	if value == nil && utils.IsNil(utils.Zero[T]()) {
+		c.addZero()
+		return true
+	}
+	return false
+}
+
+func (c *columnImp[T]) addZero() {
+	c.values.Append(utils.Zero[T]())
+}
+
+func (c *columnImp[T]) addFrom(other columnData, index int) {
+	c.values.Append(other.(*columnImp[T]).values.Get(index))
+}
+
+func (c *columnImp[T]) subset(rows []int) columnData {
+	result := newColumnData(c.col, len(rows))
+	for _, index := range rows {
+		result.values.Append(c.values.Get(index))
+	}
+	return result
+}
+
+func (c *columnImp[T]) comparer() func(i, j int) int {
+	cmp := c.col.comparer()
+	return func(i, j int) int {
+		return cmp(c.values.Get(i), c.values.Get(j))
+	}
+}
@@ -0,0 +1,87 @@
+package table
+
+import (
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// format writes the given table as text with a box drawn around
+// the header and each cell. Numbers are right aligned and
+// all other values are left aligned.
+func format(t *tableImp) string {
+	columns := len(t.data)
+	cells := make([][]string, t.count+1)
+	widths := make([]int, columns)
+	rightAlign := make([]bool, columns)
+
+	cells[0] = t.Columns()
+	for r := range t.count {
+		cells[r+1] = make([]string, columns)
+		for c, d := range t.data {
+			cells[r+1][c] = escape(utils.String(d.get(r)))
+		}
+	}
+	for c, d := range t.data {
+		rightAlign[c] = isNumber(d.header().Type())
+		for _, row := range cells {
+			widths[c] = max(widths[c], utf8.RuneCountInString(row[c]))
+		}
+	}
+
+	buf := &strings.Builder{}
+	writeBorder(buf, widths, `┌`, `┬`, `┐`)
+	writeCells(buf, widths, cells[0], nil)
+	writeBorder(buf, widths, `├`, `┼`, `┤`)
+	for _, row := range cells[1:] {
+		writeCells(buf, widths, row, rightAlign)
+	}
+	writeBorder(buf, widths, `└`, `┴`, `┘`)
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// escape replaces any characters in a cell which would break the table layout.
+func escape(value string) string {
+	return strings.NewReplacer("\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(value)
+}
+
+// isNumber determines if the given type is a number type.
+func isNumber(typ reflect.Type) bool {
+	switch typ.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Uintptr, reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+func writeBorder(buf *strings.Builder, widths []int, left, middle, right string) {
+	buf.WriteString(left)
+	for c, width := range widths {
+		if c > 0 {
+			buf.WriteString(middle)
+		}
+		buf.WriteString(strings.Repeat(`─`, width+2))
+	}
+	buf.WriteString(right)
+	buf.WriteString("\n")
+}
+
+func writeCells(buf *strings.Builder, widths []int, cells []string, rightAlign []bool) {
+	buf.WriteString(`│`)
+	for c, cell := range cells {
+		pad := strings.Repeat(` `, widths[c]-utf8.RuneCountInString(cell))
+		buf.WriteString(` `)
+		if rightAlign != nil && rightAlign[c] {
+			buf.WriteString(pad + cell)
+		} else {
+			buf.WriteString(cell + pad)
+		}
+		buf.WriteString(` │`)
+	}
+	buf.WriteString("\n")
+}
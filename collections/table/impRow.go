@@ -0,0 +1,96 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type rowImp struct {
+	t     *tableImp
+	index int
+}
+
+func (r rowImp) Table() Table {
+	return r.t
+}
+
+func (r rowImp) Index() int {
+	return r.index
+}
+
+func (r rowImp) Count() int {
+	return len(r.t.data)
+}
+
+func (r rowImp) Get(index int) any {
+	if value, ok := r.TryGet(index); ok {
+		return value
+	}
+	panic(terror.OutOfBounds(index, r.Count()))
+}
+
+func (r rowImp) TryGet(index int) (any, bool) {
+	if index < 0 || index >= len(r.t.data) {
+		return nil, false
+	}
+	return r.t.data[index].get(r.index), true
+}
+
+func (r rowImp) Value(column string) any {
+	if value, ok := r.TryValue(column); ok {
+		return value
+	}
+	panic(unknownColumn(column))
+}
+
+func (r rowImp) TryValue(column string) (any, bool) {
+	if index, ok := r.t.names[column]; ok {
+		return r.t.data[index].get(r.index), true
+	}
+	return nil, false
+}
+
+func (r rowImp) ToSlice() []any {
+	s := make([]any, len(r.t.data))
+	r.CopyToSlice(s)
+	return s
+}
+
+func (r rowImp) CopyToSlice(s []any) {
+	for i := range min(len(s), len(r.t.data)) {
+		s[i] = r.t.data[i].get(r.index)
+	}
+}
+
+func (r rowImp) ToMap() map[string]any {
+	m := make(map[string]any, len(r.t.data))
+	for _, data := range r.t.data {
+		m[data.header().Name()] = data.get(r.index)
+	}
+	return m
+}
+
+func (r rowImp) String() string {
+	parts := make([]string, len(r.t.data))
+	for i, data := range r.t.data {
+		parts[i] = utils.String(data.get(r.index))
+	}
+	return `[` + strings.Join(parts, `, `) + `]`
+}
+
+func (r rowImp) Equals(other any) bool {
+	t, ok := other.(collections.Tuple)
+	if !ok || t.Count() != r.Count() {
+		return false
+	}
+	for i, data := range r.t.data {
+		if !comp.Equal(data.get(r.index), t.Get(i)) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,29 @@
+package table
+
+import "github.com/Snow-Gremlin/goToolbox/collections"
+
+// Row is one row of values in a table.
+//
+// The row is a tuple of its values in the same order as the columns
+// of the table. The values can also be read by the column name
+// or with their type by using a typed `Column`.
+type Row interface {
+	collections.Tuple
+
+	// Table gets the table this row belongs to.
+	Table() Table
+
+	// Index gets the index of this row in its table.
+	Index() int
+
+	// Value gets the value in the column with the given name.
+	// This will panic if there is no column with the given name.
+	Value(column string) any
+
+	// TryValue gets the value in the column with the given name.
+	// Returns nil and false if there is no column with the given name.
+	TryValue(column string) (any, bool)
+
+	// ToMap gets the values of this row keyed by the column names.
+	ToMap() map[string]any
+}
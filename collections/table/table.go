@@ -0,0 +1,147 @@
+package table
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Table is an in-memory table of rows of values in typed columns.
+//
+// The operators which query a table, such as `Where`, `Select`,
+// `Sort`, `GroupBy`, and `Join`, create a new table with the results
+// so that the results can be queried further or printed.
+type Table interface {
+	collections.Countable
+	collections.Enumerable[Row]
+	utils.Stringer
+
+	// Empty determines if there are no rows in the table.
+	Empty() bool
+
+	// Columns gets the names of the columns in the order of the columns.
+	Columns() []string
+
+	// Headers gets the headers of the columns in the order of the columns.
+	Headers() []Header
+
+	// Header gets the header of the column with the given name.
+	// This will panic if there is no column with the given name.
+	Header(column string) Header
+
+	// HasColumn determines if there is a column with the given name.
+	HasColumn(column string) bool
+
+	// Row gets the row at the given index.
+	// This will panic if the index is out-of-bounds.
+	Row(index int) Row
+
+	// AddRow adds a row with the given values to the end of the table.
+	// There must be one value for each column in the order of the columns.
+	// A nil value may be given for a column of a type which can be nil.
+	// This will panic if the number of values or the type of any value is wrong.
+	AddRow(values ...any)
+
+	// AddMap adds a row with the given values, keyed by column name,
+	// to the end of the table. Any column without a value is given
+	// the zero value. This will panic if there is a value for a column
+	// which doesn't exist or the type of any value is wrong.
+	AddMap(values map[string]any)
+
+	// Maps enumerates the rows of the table as maps of the values
+	// keyed by the column names.
+	Maps() collections.Enumerator[map[string]any]
+
+	// Where creates a new table with only the rows which
+	// the given predicate returns true for.
+	Where(p collections.Predicate[Row]) Table
+
+	// Select creates a new table with only the columns with the given names
+	// in the given order. This will panic if any column doesn't exist
+	// or the same column is selected more than once.
+	Select(columns ...string) Table
+
+	// Extend creates a new table with the columns of this table followed by
+	// the given computed columns. This will panic if the names of the new
+	// columns are not unique.
+	Extend(columns ...Computed) Table
+
+	// Sort creates a new table with the rows sorted by the given comparers.
+	// The rows are compared by the first comparer, then any rows which are
+	// equal are compared by the next comparer, and so on.
+	// The sort is stable so rows which are equal for all comparers keep their order.
+	// Use `Ascending` and `Descending` on a `Column` to create comparers.
+	Sort(comparers ...comp.Comparer[Row]) Table
+
+	// SortBy creates a new table with the rows sorted in ascending order by
+	// the values in the columns with the given names, the first column first.
+	// This will panic if any column doesn't exist or doesn't have a comparer.
+	SortBy(columns ...string) Table
+
+	// GroupBy creates a new table with one row for each unique combination of
+	// values in the columns with the given key names. The new table has the
+	// key columns followed by the given aggregate columns. The rows are in the
+	// order that each combination of key values first appeared.
+	//
+	// This will panic if any key column doesn't exist, the values of any key column
+	// aren't comparable, or any of the new column names are not unique.
+	// Columns of interface types, e.g. `any`, may not be used as key columns
+	// since the values they hold may not be comparable.
+	GroupBy(keys []string, aggregates ...Aggregate) Table
+
+	// Join creates a new table with the inner join of this table with
+	// the other table. Each row of this table is joined with every row of
+	// the other table which has equal values in the columns with the given names.
+	// The new table has the columns of this table followed by the columns
+	// of the other table, except for the columns being joined on.
+	//
+	// This will panic if any column being joined on doesn't exist in both tables,
+	// has different types, or its values aren't comparable.
+	// This will also panic if the names of the new columns are not unique.
+	Join(other Table, on ...string) Table
+
+	// LeftJoin creates a new table with the left outer join of this table with
+	// the other table. This is the same as `Join` except that rows of this table
+	// which don't match any row of the other table are kept and given the zero
+	// values for the columns from the other table.
+	LeftJoin(other Table, on ...string) Table
+
+	// Clone creates a copy of this table.
+	Clone() Table
+}
+
+// New creates a new empty table with the given columns.
+// This will panic if the column names are not unique.
+func New(headers ...Header) Table {
+	return newTable(headers, 0)
+}
+
+// From creates a new table with the given columns and a row
+// for each slice of values from the given enumerator.
+// There must be one value in each slice for each column in the order
+// of the columns. This will panic if the column names are not unique,
+// or the number of values or the type of any value is wrong.
+func From(e collections.Enumerator[[]any], headers ...Header) Table {
+	t := newTable(headers, 0)
+	e.Foreach(func(values []any) {
+		t.AddRow(values...)
+	})
+	return t
+}
+
+// FromSelect creates a new table with the given columns and a row for each
+// value from the given enumerator. The given selector gets the values for a row
+// from each value, with one value for each column in the order of the columns.
+// This will panic if the column names are not unique,
+// or the number of values or the type of any value is wrong.
+func FromSelect[T any](e collections.Enumerator[T], selector collections.Selector[T, []any], headers ...Header) Table {
+	if utils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	t := newTable(headers, 0)
+	e.Foreach(func(value T) {
+		t.AddRow(selector(value)...)
+	})
+	return t
+}
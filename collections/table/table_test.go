@@ -0,0 +1,261 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/set"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+var (
+	name  = Col[string](`name`)
+	kind  = Col[string](`kind`)
+	legs  = Col[int](`legs`)
+	speed = Col[float64](`speed`)
+)
+
+func animals() Table {
+	t := New(name, kind, legs, speed)
+	t.AddRow(`cat`, `mammal`, 4, 48.0)
+	t.AddRow(`eagle`, `bird`, 2, 320.0)
+	t.AddRow(`dog`, `mammal`, 4, 70.0)
+	t.AddRow(`snake`, `reptile`, 0, 20.0)
+	t.AddRow(`ostrich`, `bird`, 2, 70.0)
+	return t
+}
+
+func Test_Table_New(t *testing.T) {
+	tab := animals()
+	check.Equal(t, 5).Assert(tab.Count())
+	check.False(t).Assert(tab.Empty())
+	check.Equal(t, []string{`name`, `kind`, `legs`, `speed`}).Assert(tab.Columns())
+	check.True(t).Assert(tab.HasColumn(`legs`))
+	check.False(t).Assert(tab.HasColumn(`wings`))
+	check.Equal(t, legs.Type()).Assert(tab.Header(`legs`).Type())
+	check.Equal(t, 4).Assert(len(tab.Headers()))
+	check.True(t).Assert(New(name).Empty())
+
+	check.MatchError(t, `^the column names in a table must be unique \{column: name\}$`).Panic(func() {
+		New(name, kind, Col[int](`name`))
+	})
+	check.MatchError(t, `^a column name may not be empty$`).Panic(func() {
+		Col[int](``)
+	})
+	check.MatchError(t, `^no column with the given name exists in the table \{column: wings\}$`).Panic(func() {
+		tab.Header(`wings`)
+	})
+}
+
+func Test_Table_AddRow(t *testing.T) {
+	tab := New(name, legs, Col[collections.Set[string]](`tags`))
+	tab.AddRow(`cat`, 4, set.With(`fuzzy`))
+	tab.AddRow(`snake`, 0, nil)
+	tab.AddMap(map[string]any{`name`: `fish`})
+	check.Equal(t, 3).Assert(tab.Count())
+	check.Equal(t, 0).Assert(legs.Get(tab.Row(2)))
+	check.Nil(t).Assert(tab.Row(1).Value(`tags`))
+
+	check.MatchError(t, `^the number of values must match the number of columns \{columns: 3, values: 2\}$`).Panic(func() {
+		tab.AddRow(`cat`, 4)
+	})
+	check.MatchError(t, `^the value is not the type of the column \{column: legs, type: int, value type: string\}$`).Panic(func() {
+		tab.AddRow(`cat`, `four`, nil)
+	})
+	check.MatchError(t, `^the value is not the type of the column \{column: legs, type: int, value type: <nil>\}$`).Panic(func() {
+		tab.AddRow(`cat`, nil, nil)
+	})
+	check.MatchError(t, `^no column with the given name exists in the table \{column: wings\}$`).Panic(func() {
+		tab.AddMap(map[string]any{`wings`: 2})
+	})
+	check.Equal(t, 3).Assert(tab.Count())
+}
+
+func Test_Table_Rows(t *testing.T) {
+	tab := animals()
+	row := tab.Row(1)
+	check.Equal(t, 1).Assert(row.Index())
+	check.Equal(t, 4).Assert(row.Count())
+	check.Equal[any](t, `eagle`).Assert(row.Get(0))
+	check.Equal[any](t, `bird`).Assert(row.Value(`kind`))
+	check.Equal(t, []any{`eagle`, `bird`, 2, 320.0}).Assert(row.ToSlice())
+	check.Equal(t, map[string]any{`name`: `eagle`, `kind`: `bird`, `legs`: 2, `speed`: 320.0}).Assert(row.ToMap())
+	check.Equal(t, `[eagle, bird, 2, 320]`).Assert(row.String())
+	check.True(t).Assert(row.Equals(tab.Clone().Row(1)))
+	check.False(t).Assert(row.Equals(tab.Row(2)))
+
+	_, ok := row.TryGet(4)
+	check.False(t).Assert(ok)
+	_, ok = row.TryValue(`wings`)
+	check.False(t).Assert(ok)
+	check.MatchError(t, `^index out of bounds \{count: 5, index: 5\}$`).Panic(func() {
+		tab.Row(5)
+	})
+
+	check.Equal(t, []string{`cat`, `eagle`, `dog`, `snake`, `ostrich`}).Assert(name.Values(tab).ToSlice())
+	sum, _ := enumerator.Sum(legs.Values(tab))
+	check.Equal(t, 12).Assert(sum)
+	last, _ := tab.Maps().Last()
+	check.Equal[any](t, `ostrich`).Assert(last[`name`])
+}
+
+func Test_Table_Column(t *testing.T) {
+	tab := animals()
+	check.Equal(t, `dog`).Assert(name.Get(tab.Row(2)))
+
+	_, ok := Col[int](`name`).TryGet(tab.Row(2))
+	check.False(t).Assert(ok)
+	check.MatchError(t, `^the column in the table is not the expected type \{actual: string, column: name, expected: int\}$`).Panic(func() {
+		Col[int](`name`).Get(tab.Row(2))
+	})
+	check.MatchError(t, `^no column with the given name exists in the table \{column: wings\}$`).Panic(func() {
+		Col[int](`wings`).Get(tab.Row(2))
+	})
+	check.MatchError(t, `^must provide a comparer to compare the values of this column \{column: tags, type: .+\}$`).Panic(func() {
+		Col[collections.Set[string]](`tags`).Ascending()
+	})
+}
+
+func Test_Table_Where(t *testing.T) {
+	tab := animals().Where(legs.Is(func(v int) bool { return v == 4 }))
+	check.Equal(t, []string{`cat`, `dog`}).Assert(name.Values(tab).ToSlice())
+
+	tab = animals().Where(func(row Row) bool { return speed.Get(row) > 1000 })
+	check.True(t).Assert(tab.Empty())
+	check.Equal(t, 4).Assert(len(tab.Columns()))
+}
+
+func Test_Table_Select(t *testing.T) {
+	tab := animals().Select(`speed`, `name`)
+	check.Equal(t, []string{`speed`, `name`}).Assert(tab.Columns())
+	check.Equal(t, []any{48.0, `cat`}).Assert(tab.Row(0).ToSlice())
+
+	check.MatchError(t, `^the column names in a table must be unique \{column: name\}$`).Panic(func() {
+		animals().Select(`name`, `name`)
+	})
+}
+
+func Test_Table_Extend(t *testing.T) {
+	pace := Compute(`pace`, func(row Row) string {
+		if speed.Get(row) >= 70 {
+			return `fast`
+		}
+		return `slow`
+	})
+	tab := animals().Extend(pace).Select(`name`, `pace`)
+	check.Equal(t, []string{`slow`, `fast`, `fast`, `slow`, `fast`}).Assert(Col[string](`pace`).Values(tab).ToSlice())
+
+	check.MatchError(t, `^the column names in a table must be unique \{column: legs\}$`).Panic(func() {
+		animals().Extend(Compute(`legs`, legs.Get))
+	})
+	check.MatchError(t, `^argument may not be nil \{name: selector\}$`).Panic(func() {
+		Compute[int](`legs`, nil)
+	})
+}
+
+func Test_Table_Sort(t *testing.T) {
+	tab := animals().Sort(speed.Descending(), name.Ascending())
+	check.Equal(t, []string{`eagle`, `dog`, `ostrich`, `cat`, `snake`}).Assert(name.Values(tab).ToSlice())
+
+	tab = animals().SortBy(`kind`, `speed`)
+	check.Equal(t, []string{`ostrich`, `eagle`, `cat`, `dog`, `snake`}).Assert(name.Values(tab).ToSlice())
+
+	// The sort is stable.
+	tab = animals().SortBy(`legs`)
+	check.Equal(t, []string{`snake`, `eagle`, `ostrich`, `cat`, `dog`}).Assert(name.Values(tab).ToSlice())
+
+	tab = New(Col[collections.Set[string]](`tags`))
+	check.MatchError(t, `^must provide a comparer to compare the values of this column \{column: tags, type: .+\}$`).Panic(func() {
+		tab.SortBy(`tags`)
+	})
+}
+
+func Test_Table_GroupBy(t *testing.T) {
+	tab := animals().GroupBy([]string{`kind`},
+		Count(`count`),
+		Sum(`total legs`, legs),
+		Average(`average speed`, speed),
+		Min(`slowest`, speed),
+		Max(`fastest`, speed),
+		Collect(`names`, name),
+		Reduce(`initials`, name, ``, func(value, prior string) string { return prior + value[:1] }))
+	check.Equal(t, []string{`kind`, `count`, `total legs`, `average speed`, `slowest`, `fastest`, `names`, `initials`}).
+		Assert(tab.Columns())
+	check.Equal(t, 3).Assert(tab.Count())
+	check.Equal(t, []any{`mammal`, 2, 8, 59.0, 48.0, 70.0, []string{`cat`, `dog`}, `cd`}).Assert(tab.Row(0).ToSlice())
+	check.Equal(t, []any{`bird`, 2, 4, 195.0, 70.0, 320.0, []string{`eagle`, `ostrich`}, `eo`}).Assert(tab.Row(1).ToSlice())
+	check.Equal(t, []any{`reptile`, 1, 0, 20.0, 20.0, 20.0, []string{`snake`}, `s`}).Assert(tab.Row(2).ToSlice())
+
+	tab = animals().GroupBy([]string{`kind`, `legs`})
+	check.Equal(t, 3).Assert(tab.Count())
+
+	check.MatchError(t, `^the values of a key column must be comparable \{column: tags, type: \[\]string\}$`).Panic(func() {
+		New(Col[[]string](`tags`)).GroupBy([]string{`tags`})
+	})
+	check.MatchError(t, `^the values of a key column must be comparable \{column: value, type: interface \{\}\}$`).Panic(func() {
+		New(Col[any](`value`)).GroupBy([]string{`value`})
+	})
+	check.MatchError(t, `^the column names in a table must be unique \{column: kind\}$`).Panic(func() {
+		animals().GroupBy([]string{`kind`}, Count(`kind`))
+	})
+}
+
+func Test_Table_Join(t *testing.T) {
+	sounds := New(kind, Col[string](`sound`))
+	sounds.AddRow(`mammal`, `growl`)
+	sounds.AddRow(`bird`, `chirp`)
+	sounds.AddRow(`bird`, `squawk`)
+	sounds.AddRow(`fish`, `blub`)
+
+	tab := animals().Join(sounds, `kind`)
+	check.Equal(t, []string{`name`, `kind`, `legs`, `speed`, `sound`}).Assert(tab.Columns())
+	check.Equal(t, 6).Assert(tab.Count())
+	check.Equal(t, []string{`cat`, `eagle`, `eagle`, `dog`, `ostrich`, `ostrich`}).Assert(name.Values(tab).ToSlice())
+	check.Equal(t, []string{`growl`, `chirp`, `squawk`, `growl`, `chirp`, `squawk`}).Assert(Col[string](`sound`).Values(tab).ToSlice())
+
+	tab = animals().LeftJoin(sounds, `kind`)
+	check.Equal(t, 7).Assert(tab.Count())
+	check.Equal(t, []any{`snake`, `reptile`, 0, 20.0, ``}).Assert(tab.Row(4).ToSlice())
+
+	check.MatchError(t, `^the columns being joined on must have the same type in both tables \{column: kind, left type: string, right type: int\}$`).Panic(func() {
+		animals().Join(New(Col[int](`kind`)), `kind`)
+	})
+	check.MatchError(t, `^no column with the given name exists in the table \{column: legs\}$`).Panic(func() {
+		animals().Join(sounds, `legs`)
+	})
+	check.MatchError(t, `^the column names in a table must be unique \{column: legs\}$`).Panic(func() {
+		animals().Join(New(kind, legs), `kind`)
+	})
+}
+
+func Test_Table_From(t *testing.T) {
+	tab := From(enumerator.Enumerate([]any{`a`, 1}, []any{`b`, 2}), name, legs)
+	check.Equal(t, 2).Assert(tab.Count())
+	sum, _ := enumerator.Sum(legs.Values(tab))
+	check.Equal(t, 3).Assert(sum)
+
+	tab = FromSelect(enumerator.Enumerate(`one`, `three`), func(s string) []any {
+		return []any{s, len(s)}
+	}, name, legs)
+	check.Equal(t, []any{`three`, 5}).Assert(tab.Row(1).ToSlice())
+}
+
+func Test_Table_String(t *testing.T) {
+	tab := animals().Where(kind.Is(func(v string) bool { return v != `bird` })).
+		Sort(Col(`name`, comp.Ordered[string]()).Ascending())
+	tab.AddRow("Zoë\nthe cat", `mammal`, 4, 50.5)
+	exp := []string{
+		`┌──────────────┬─────────┬──────┬───────┐`,
+		`│ name         │ kind    │ legs │ speed │`,
+		`├──────────────┼─────────┼──────┼───────┤`,
+		`│ cat          │ mammal  │    4 │    48 │`,
+		`│ dog          │ mammal  │    4 │    70 │`,
+		`│ snake        │ reptile │    0 │    20 │`,
+		`│ Zoë\nthe cat │ mammal  │    4 │  50.5 │`,
+		`└──────────────┴─────────┴──────┴───────┘`,
+	}
+	check.Equal(t, strings.Join(exp, "\n")).Assert(tab.String())
+}
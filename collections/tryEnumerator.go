@@ -0,0 +1,70 @@
+package collections
+
+import "iter"
+
+// TryEnumerator is a tool for walking through a collection of data
+// from a source which may fail to read a value.
+//
+// The operators which return a new enumerator pass along any error from the source.
+// The terminal operators return the first error which stopped the enumeration.
+type TryEnumerator[T any] interface {
+	// Iterate creates a new fallible iterator.
+	Iterate() TryIterator[T]
+
+	// Seq gets the sequence function iterator.
+	// If an error occurs, the error is yielded with a zero value
+	// as the last pair in the sequence.
+	Seq() iter.Seq2[T, error]
+
+	// Where filters the enumeration to only values which satisfy the predicate.
+	Where(p Predicate[T]) TryEnumerator[T]
+
+	// WhereNot filters the enumeration to only values which do not satisfy the predicate.
+	WhereNot(p Predicate[T]) TryEnumerator[T]
+
+	// Skip skips over the given count of values before returning the rest.
+	Skip(count int) TryEnumerator[T]
+
+	// SkipWhile skips over values until the given predicate returns false.
+	SkipWhile(p Predicate[T]) TryEnumerator[T]
+
+	// Take enumerates the given number of values before stopping enumeration.
+	Take(count int) TryEnumerator[T]
+
+	// TakeWhile enumerates the values until the given predicate returns false.
+	TakeWhile(p Predicate[T]) TryEnumerator[T]
+
+	// Foreach runs the given function for each value in the enumerator.
+	// Returns the first error which stopped the enumeration, or nil.
+	Foreach(m func(value T)) error
+
+	// DoUntilError runs the given function for each value in the enumerator.
+	// If the given function or the enumeration returns an error,
+	// the error will be returned right away, otherwise nil is returned.
+	DoUntilError(s Selector[T, error]) error
+
+	// ToSlice reads all the values into a slice.
+	// Returns the first error which stopped the enumeration, or nil.
+	ToSlice() ([]T, error)
+
+	// Count reads all the values and returns the number of values.
+	// Returns the first error which stopped the enumeration, or nil.
+	Count() (int, error)
+
+	// First returns the first value in the enumerator with true,
+	// or zero value with false if the enumerator is empty.
+	// Returns the error if reading the first value failed, or nil.
+	First() (T, bool, error)
+
+	// Any determines if any value in the enumerator satisfies the given predicate.
+	// Returns the first error which stopped the enumeration, or nil.
+	Any(p Predicate[T]) (bool, error)
+
+	// All determines if all of the values in the enumerator satisfies the given predicate.
+	// Returns the first error which stopped the enumeration, or nil.
+	All(p Predicate[T]) (bool, error)
+
+	// Enumerate gets an infallible enumerator for the values in this enumerator.
+	// If an error occurs while enumerating, the error will be panicked.
+	Enumerate() Enumerator[T]
+}
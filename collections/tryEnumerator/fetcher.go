@@ -0,0 +1,10 @@
+package tryEnumerator
+
+// Fetcher is the source of values which can be used in fallible iterators.
+//
+// Each time it is called it can return a different value.
+// Returns true if a new value was fetched and false if there are no new values.
+// Returns an error if the value failed to be fetched, the iterator will stop
+// at the first error. Once false or an error is returned it is expected to
+// always return false from then on.
+type Fetcher[T any] func() (T, bool, error)
@@ -0,0 +1,179 @@
+package tryEnumerator
+
+import (
+	"iter"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+type tryEnumeratorImp[T any] struct {
+	iterable collections.TryIterable[T]
+}
+
+// failed wraps the error from the given iterator, if any, with the index,
+// in the source values, of the value which was being read when the error
+// occurred. The given count of values read is used if the index is unknown.
+func failed[T any](it collections.TryIterator[T], count int) error {
+	err := it.Err()
+	if err == nil {
+		return nil
+	}
+	return terror.New(`failed to enumerate value`, err).
+		With(`index`, indexOf(it, count))
+}
+
+func (e tryEnumeratorImp[T]) Iterate() collections.TryIterator[T] {
+	return e.iterable()
+}
+
+func (e tryEnumeratorImp[T]) Seq() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		it := e.Iterate()
+		defer iterator.Close[T](it)
+		index := 0
+		for ; it.Next(); index++ {
+			if !yield(it.Current(), nil) {
+				return
+			}
+		}
+		if err := failed(it, index); err != nil {
+			yield(utils.Zero[T](), err)
+		}
+	}
+}
+
+func (e tryEnumeratorImp[T]) chain(op func(it collections.Iterator[T]) collections.Iterator[T]) collections.TryEnumerator[T] {
+	return New(func() collections.TryIterator[T] {
+		it := e.Iterate()
+		return wrap(op(it), it)
+	})
+}
+
+func (e tryEnumeratorImp[T]) Where(p collections.Predicate[T]) collections.TryEnumerator[T] {
+	return e.chain(func(it collections.Iterator[T]) collections.Iterator[T] {
+		return iterator.Where(it, p)
+	})
+}
+
+func (e tryEnumeratorImp[T]) WhereNot(p collections.Predicate[T]) collections.TryEnumerator[T] {
+	return e.Where(predicate.Not(p))
+}
+
+func (e tryEnumeratorImp[T]) Skip(count int) collections.TryEnumerator[T] {
+	return e.chain(func(it collections.Iterator[T]) collections.Iterator[T] {
+		return iterator.Skip(it, count)
+	})
+}
+
+func (e tryEnumeratorImp[T]) SkipWhile(p collections.Predicate[T]) collections.TryEnumerator[T] {
+	return e.chain(func(it collections.Iterator[T]) collections.Iterator[T] {
+		return iterator.SkipWhile(it, p)
+	})
+}
+
+func (e tryEnumeratorImp[T]) Take(count int) collections.TryEnumerator[T] {
+	return e.chain(func(it collections.Iterator[T]) collections.Iterator[T] {
+		return iterator.Take(it, count)
+	})
+}
+
+func (e tryEnumeratorImp[T]) TakeWhile(p collections.Predicate[T]) collections.TryEnumerator[T] {
+	return e.chain(func(it collections.Iterator[T]) collections.Iterator[T] {
+		return iterator.TakeWhile(it, p)
+	})
+}
+
+func (e tryEnumeratorImp[T]) Foreach(m func(value T)) error {
+	it := e.Iterate()
+	defer iterator.Close[T](it)
+	index := 0
+	for ; it.Next(); index++ {
+		m(it.Current())
+	}
+	return failed(it, index)
+}
+
+func (e tryEnumeratorImp[T]) DoUntilError(s collections.Selector[T, error]) error {
+	it := e.Iterate()
+	defer iterator.Close[T](it)
+	index := 0
+	for ; it.Next(); index++ {
+		if err := s(it.Current()); err != nil {
+			return err
+		}
+	}
+	return failed(it, index)
+}
+
+func (e tryEnumeratorImp[T]) ToSlice() ([]T, error) {
+	s := []T{}
+	err := e.Foreach(func(value T) {
+		s = append(s, value)
+	})
+	return s, err
+}
+
+func (e tryEnumeratorImp[T]) Count() (int, error) {
+	count := 0
+	err := e.Foreach(func(T) {
+		count++
+	})
+	return count, err
+}
+
+func (e tryEnumeratorImp[T]) First() (T, bool, error) {
+	it := e.Iterate()
+	defer iterator.Close[T](it)
+	if it.Next() {
+		return it.Current(), true, nil
+	}
+	return utils.Zero[T](), false, failed(it, 0)
+}
+
+func (e tryEnumeratorImp[T]) Any(p collections.Predicate[T]) (bool, error) {
+	it := e.Iterate()
+	defer iterator.Close[T](it)
+	index := 0
+	for ; it.Next(); index++ {
+		if p(it.Current()) {
+			return true, nil
+		}
+	}
+	return false, failed(it, index)
+}
+
+func (e tryEnumeratorImp[T]) All(p collections.Predicate[T]) (bool, error) {
+	it := e.Iterate()
+	defer iterator.Close[T](it)
+	index := 0
+	for ; it.Next(); index++ {
+		if !p(it.Current()) {
+			return false, nil
+		}
+	}
+	return true, failed(it, index)
+}
+
+func (e tryEnumeratorImp[T]) Enumerate() collections.Enumerator[T] {
+	return enumerator.New(func() collections.Iterator[T] {
+		it := e.Iterate()
+		index := 0
+		return iterator.NewClosable(func() (T, bool) {
+			if it.Next() {
+				index++
+				return it.Current(), true
+			}
+			if err := failed(it, index); err != nil {
+				panic(err)
+			}
+			return utils.Zero[T](), false
+		}, func() error {
+			return iterator.Close[T](it)
+		})
+	})
+}
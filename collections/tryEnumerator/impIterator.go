@@ -0,0 +1,55 @@
+package tryEnumerator
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+)
+
+type tryIteratorImp[T any] struct {
+	collections.Iterator[T]
+	err   func() error
+	index func() int
+}
+
+// indexer is a fallible iterator which knows the index,
+// in the source values, of the value which failed to be read.
+type indexer interface {
+	failedIndex() int
+}
+
+// wrap creates a fallible iterator from an iterator with
+// a function to get the error from the fallible source.
+// The index of the failure is taken from the given source.
+func wrap[T, TSrc any](it collections.Iterator[T], src collections.TryIterator[TSrc]) collections.TryIterator[T] {
+	return tryIteratorImp[T]{
+		Iterator: it,
+		err:      src.Err,
+		index:    func() int { return indexOf(src, 0) },
+	}
+}
+
+// indexOf gets the index, in the source values, of the value which failed
+// to be read from the given iterator. If the iterator doesn't know the index,
+// the given fallback, the number of values read from the iterator, is used.
+func indexOf[T any](it collections.TryIterator[T], fallback int) int {
+	if i, ok := it.(indexer); ok {
+		return i.failedIndex()
+	}
+	return fallback
+}
+
+func noError() error {
+	return nil
+}
+
+func (it tryIteratorImp[T]) Err() error {
+	return it.err()
+}
+
+func (it tryIteratorImp[T]) failedIndex() int {
+	return it.index()
+}
+
+func (it tryIteratorImp[T]) Close() error {
+	return iterator.Close(it.Iterator)
+}
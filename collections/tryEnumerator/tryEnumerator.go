@@ -0,0 +1,201 @@
+package tryEnumerator
+
+import (
+	"io"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/internal/optional"
+	"github.com/Snow-Gremlin/goToolbox/internal/readers"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// New creates a new fallible enumerator around the given iterator factory.
+// This will pull values from the given iterable.
+func New[T any](iterable collections.TryIterable[T]) collections.TryEnumerator[T] {
+	return tryEnumeratorImp[T]{
+		iterable: iterable,
+	}
+}
+
+// NewIterator creates a new fallible iterator for stepping through values
+// using a fetcher. As soon as the fetcher returns false or an error,
+// this iterator will stop.
+func NewIterator[T any](fetcher Fetcher[T]) collections.TryIterator[T] {
+	var err error
+	index := 0
+	it := iterator.New(func() (T, bool) {
+		if fetcher == nil {
+			return utils.Zero[T](), false
+		}
+		value, ok, e := fetcher()
+		if e != nil {
+			err = e
+			fetcher = nil
+			return utils.Zero[T](), false
+		}
+		if ok {
+			index++
+		}
+		return value, ok
+	})
+	return tryIteratorImp[T]{
+		Iterator: it,
+		err:      func() error { return err },
+		index:    func() int { return index },
+	}
+}
+
+// From creates a fallible enumerator from the given infallible enumerator.
+// The returned enumerator will never return an error.
+func From[T any](e collections.Enumerator[T]) collections.TryEnumerator[T] {
+	return New(func() collections.TryIterator[T] {
+		return tryIteratorImp[T]{
+			Iterator: e.Iterate(),
+			err:      noError,
+			index:    func() int { return 0 },
+		}
+	})
+}
+
+// Fail creates a fallible enumerator which fails right away
+// with the given error without returning any values.
+func Fail[T any](err error) collections.TryEnumerator[T] {
+	if utils.IsNil(err) {
+		panic(terror.NilArg(`err`))
+	}
+	return New(func() collections.TryIterator[T] {
+		return NewIterator(func() (T, bool, error) {
+			return utils.Zero[T](), false, err
+		})
+	})
+}
+
+// Select changes one fallible enumerator type into another by converting each value.
+// Any error from the given enumerator is passed along.
+func Select[TIn, TOut any](e collections.TryEnumerator[TIn], selector collections.Selector[TIn, TOut]) collections.TryEnumerator[TOut] {
+	if utils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	return New(func() collections.TryIterator[TOut] {
+		it := e.Iterate()
+		return wrap(iterator.Select(it, selector), it)
+	})
+}
+
+// TrySelect changes one fallible enumerator type into another by converting
+// each value with a selector which may fail. If the selector returns an error,
+// the enumeration stops with that error.
+// Any error from the given enumerator is also passed along.
+func TrySelect[TIn, TOut any](e collections.TryEnumerator[TIn], selector func(value TIn) (TOut, error)) collections.TryEnumerator[TOut] {
+	if utils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	return New(func() collections.TryIterator[TOut] {
+		it := e.Iterate()
+		read, sourceFailed := 0, false
+		out := NewIterator(func() (TOut, bool, error) {
+			if !it.Next() {
+				sourceFailed = true
+				return utils.Zero[TOut](), false, it.Err()
+			}
+			read++
+			value, err := selector(it.Current())
+			return value, err == nil, err
+		})
+		return tryIteratorImp[TOut]{
+			Iterator: out,
+			err:      out.Err,
+			index: func() int {
+				if sourceFailed {
+					return indexOf(it, read)
+				}
+				return read - 1
+			},
+		}
+	})
+}
+
+// ReaderSplit creates a fallible enumerator that lazily enumerates all the
+// strings from splitting the data read from the given reader with the given
+// separator function. The matched separators will not be returned. The strings
+// are split the same as `enumerator.SplitFunc` would split the whole data
+// read into a string.
+// The strings given to the separator are only valid until it returns,
+// so the separator must not keep them.
+//
+// This can take an optional maximum token size, the default is
+// `bufio.MaxScanTokenSize`. If a string between separators is longer than
+// the maximum token size, or reading from the reader fails, the enumeration
+// stops with the error.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderSplit(r io.Reader, separator utils.StringMatcher, maxTokenSize ...int) collections.TryEnumerator[string] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	if utils.IsNil(separator) {
+		panic(terror.NilArg(`separator`))
+	}
+	maxSize := optional.TokenSize(maxTokenSize)
+	return readerEnumerator(readers.Split(r, separator, maxSize))
+}
+
+// ReaderLines creates a fallible enumerator that lazily enumerates all the
+// lines read from the given reader. The lines are split the same as
+// `enumerator.Lines`. This is useful for streaming through a large file
+// line by line.
+//
+// This can take an optional maximum line length, the default is
+// `bufio.MaxScanTokenSize`. If a line is longer than the maximum line length,
+// or reading from the reader fails, the enumeration stops with the error.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderLines(r io.Reader, maxTokenSize ...int) collections.TryEnumerator[string] {
+	return ReaderSplit(r, readers.LineSeparator, maxTokenSize...)
+}
+
+// ReaderRunes creates a fallible enumerator that lazily enumerates all the
+// runes decoded from the UTF-8 data read from the given reader.
+// Any invalid UTF-8 encoding is returned as `utf8.RuneError`.
+// If reading from the reader fails, the enumeration stops with the error.
+//
+// If the reader is an `io.Seeker`, each iteration seeks back to the position
+// the reader was at when first iterated so the enumerator can be re-iterated.
+// Otherwise each iteration continues reading from where the prior iteration
+// stopped. Only one iterator should be reading from the reader at a time.
+func ReaderRunes(r io.Reader) collections.TryEnumerator[rune] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	return readerEnumerator(readers.Runes(r))
+}
+
+// readerEnumerator creates a fallible enumerator for the values from the given source.
+func readerEnumerator[T any](source readers.Source[T]) collections.TryEnumerator[T] {
+	return New(func() collections.TryIterator[T] {
+		return NewIterator(Fetcher[T](source()))
+	})
+}
+
+// Reduce performs a reduction of the values in the given fallible enumerator.
+// The reduce method is called with the prior returned value from the previous call.
+// The first call is given the initial value.
+// The last returned value from reduce is returned, or init if no values.
+// If the enumeration fails, the zero value and the error are returned.
+func Reduce[TIn, TOut any](e collections.TryEnumerator[TIn], init TOut, reducer collections.Reducer[TIn, TOut]) (TOut, error) {
+	prior := init
+	if err := e.Foreach(func(value TIn) {
+		prior = reducer(value, prior)
+	}); err != nil {
+		return utils.Zero[TOut](), err
+	}
+	return prior, nil
+}
@@ -0,0 +1,243 @@
+package tryEnumerator
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// failAfter creates a fallible enumerator which returns the given values
+// and then fails with the given error, if the error is not nil.
+func failAfter[T any](err error, values ...T) collections.TryEnumerator[T] {
+	return New(func() collections.TryIterator[T] {
+		index := 0
+		return NewIterator(func() (T, bool, error) {
+			if index < len(values) {
+				index++
+				return values[index-1], true, nil
+			}
+			return utils.Zero[T](), false, err
+		})
+	})
+}
+
+func Test_TryEnumerator_NoError(t *testing.T) {
+	e := From(enumerator.Range(1, 5))
+	s, err := e.ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []int{1, 2, 3, 4, 5}).Assert(s)
+
+	count, err := e.Count()
+	check.NoError(t).Assert(err)
+	check.Equal(t, 5).Assert(count)
+
+	s, err = e.Where(func(v int) bool { return v%2 == 1 }).Skip(1).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []int{3, 5}).Assert(s)
+
+	check.Equal(t, []int{1, 2, 3, 4, 5}).Assert(e.Enumerate().ToSlice())
+}
+
+func Test_TryEnumerator_Error(t *testing.T) {
+	e := failAfter(errors.New(`bad read`), 1, 2, 3)
+	s, err := e.ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Assert(err)
+	check.ErrorHas[terrors.TError](t).Assert(err)
+	check.Equal(t, []int{1, 2, 3}).Assert(s)
+
+	count, err := e.Count()
+	check.MatchError(t, `bad read$`).Assert(err)
+	check.Equal(t, 3).Assert(count)
+
+	// The operators pass along the error from the source.
+	_, err = e.WhereNot(func(v int) bool { return v == 2 }).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Assert(err)
+
+	// Stopping before the failure does not return the error.
+	s, err = e.Take(2).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []int{1, 2}).Assert(s)
+
+	s, err = e.TakeWhile(func(v int) bool { return v < 3 }).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []int{1, 2}).Assert(s)
+
+	_, err = e.SkipWhile(func(v int) bool { return v < 3 }).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Assert(err)
+
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Panic(func() {
+		e.Enumerate().ToSlice()
+	})
+}
+
+func Test_TryEnumerator_Terminals(t *testing.T) {
+	e := failAfter(errors.New(`bad read`), 1, 2, 3)
+
+	found, err := e.Any(func(v int) bool { return v == 2 })
+	check.NoError(t).Assert(err)
+	check.True(t).Assert(found)
+
+	found, err = e.Any(func(v int) bool { return v == 5 })
+	check.MatchError(t, `bad read$`).Assert(err)
+	check.False(t).Assert(found)
+
+	all, err := e.All(func(v int) bool { return v < 2 })
+	check.NoError(t).Assert(err)
+	check.False(t).Assert(all)
+
+	_, err = e.All(func(v int) bool { return v < 5 })
+	check.MatchError(t, `bad read$`).Assert(err)
+
+	first, ok, err := e.First()
+	check.NoError(t).Assert(err)
+	check.True(t).Assert(ok)
+	check.Equal(t, 1).Assert(first)
+
+	_, ok, err = Fail[int](errors.New(`no source`)).First()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: no source$`).Assert(err)
+	check.False(t).Assert(ok)
+
+	_, ok, err = failAfter[int](nil).First()
+	check.NoError(t).Assert(err)
+	check.False(t).Assert(ok)
+
+	err = e.DoUntilError(func(v int) error {
+		if v == 2 {
+			return errors.New(`stop`)
+		}
+		return nil
+	})
+	check.MatchError(t, `^stop$`).Assert(err)
+
+	sum := 0
+	err = e.Foreach(func(v int) { sum += v })
+	check.MatchError(t, `bad read$`).Assert(err)
+	check.Equal(t, 6).Assert(sum)
+
+	check.MatchError(t, `^argument may not be nil \{name: err\}$`).Panic(func() {
+		Fail[int](nil)
+	})
+}
+
+func Test_TryEnumerator_Seq(t *testing.T) {
+	e := failAfter(errors.New(`bad read`), 1, 2)
+	values := []int{}
+	var last error
+	for v, err := range e.Seq() {
+		if err != nil {
+			last = err
+			break
+		}
+		values = append(values, v)
+	}
+	check.Equal(t, []int{1, 2}).Assert(values)
+	check.MatchError(t, `^failed to enumerate value \{index: 2\}: bad read$`).Assert(last)
+
+	for range e.Seq() {
+		break
+	}
+}
+
+func Test_TryEnumerator_Close(t *testing.T) {
+	closed := 0
+	e := From(enumerator.New(func() collections.Iterator[int] {
+		values := enumerator.Range(1, 5).Iterate()
+		return iterator.NewClosable(func() (int, bool) {
+			if values.Next() {
+				return values.Current(), true
+			}
+			return 0, false
+		}, func() error {
+			closed++
+			return nil
+		})
+	}))
+
+	_, _, err := e.Where(func(v int) bool { return v > 1 }).First()
+	check.NoError(t).Assert(err)
+	check.Equal(t, 1).Assert(closed)
+
+	_, err = e.Any(func(v int) bool { return v > 2 })
+	check.NoError(t).Assert(err)
+	check.Equal(t, 2).Assert(closed)
+
+	for range e.Seq() {
+		break
+	}
+	check.Equal(t, 3).Assert(closed)
+
+	value, _ := e.Enumerate().Skip(1).First()
+	check.Equal(t, 2).Assert(value)
+	check.Equal(t, 4).Assert(closed)
+}
+
+func Test_TryEnumerator_Select(t *testing.T) {
+	e := failAfter(errors.New(`bad read`), 1, 2, 3)
+	s, err := Select(e, func(v int) string { return string(rune('a' + v)) }).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Assert(err)
+	check.Equal(t, []string{`b`, `c`, `d`}).Assert(s)
+
+	s2, err := TrySelect(From(enumerator.Range(1, 5)), func(v int) (int, error) {
+		if v == 3 {
+			return 0, errors.New(`bad value`)
+		}
+		return v * 10, nil
+	}).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 2\}: bad value$`).Assert(err)
+	check.Equal(t, []int{10, 20}).Assert(s2)
+
+	// The index of a failure from the source is the index in the source.
+	_, err = TrySelect(e.Where(func(v int) bool { return v != 2 }), func(v int) (int, error) {
+		return v, nil
+	}).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: bad read$`).Assert(err)
+
+	sum, err := Reduce(From(enumerator.Range(1, 4)), 0, func(v, prior int) int { return v + prior })
+	check.NoError(t).Assert(err)
+	check.Equal(t, 10).Assert(sum)
+
+	sum, err = Reduce(e, 0, func(v, prior int) int { return v + prior })
+	check.MatchError(t, `bad read$`).Assert(err)
+	check.Equal(t, 0).Assert(sum)
+}
+
+func Test_TryEnumerator_Readers(t *testing.T) {
+	s, err := ReaderLines(strings.NewReader("Cat\ndog\r\nhot")).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []string{`Cat`, `dog`, `hot`}).Assert(s)
+
+	e := ReaderLines(strings.NewReader("Cat\ndog\nhippopotamus\nhot"), 8)
+	s, err = e.ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 2\}: `+
+		`token is longer than the maximum token size \{max token size: 8\}: `+
+		`bufio.Scanner: token too long$`).Assert(err)
+	check.Equal(t, []string{`Cat`, `dog`}).Assert(s)
+
+	// The separator may keep the strings it is given.
+	parts := []string{}
+	s, err = ReaderSplit(iotest.OneByteReader(strings.NewReader(`Cat, dog`)), func(part string) (int, int) {
+		parts = append(parts, part)
+		return strings.Index(part, `, `), 2
+	}).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []string{`Cat`, `dog`}).Assert(s)
+	check.Equal(t, []string{`C`, `Ca`, `Cat`}).Assert(parts[:3])
+
+	r := io.MultiReader(strings.NewReader(`Cat`), iotest.ErrReader(errors.New(`disk failure`)))
+	runes, err := ReaderRunes(r).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 3\}: disk failure$`).Assert(err)
+	check.Equal(t, []rune{'C', 'a', 't'}).Assert(runes)
+
+	check.MatchError(t, `^argument may not be nil \{name: r\}$`).Panic(func() {
+		ReaderRunes(nil)
+	})
+}
@@ -0,0 +1,19 @@
+package collections
+
+// TryIterator is an iterator for a source of values which may fail,
+// such as a file, a database cursor, or a parser.
+//
+// When reading a value fails, `Next` returns false
+// and `Err` returns the error which stopped the iteration.
+type TryIterator[T any] interface {
+	Iterator[T]
+
+	// Err gets the error which stopped this iterator.
+	//
+	// This returns nil while the iterator is still running
+	// or if the iterator stopped because there were no more values.
+	Err() error
+}
+
+// TryIterable is a function which constructs a new instance of a fallible iterator.
+type TryIterable[T any] func() TryIterator[T]
@@ -2,9 +2,12 @@ package comp
 
 import (
 	"cmp"
+	"reflect"
+	"slices"
 	"time"
 
 	"github.com/Snow-Gremlin/goToolbox/internal/liteUtils"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 )
 
 // Default tries to create a comparer for the given type.
@@ -205,6 +208,109 @@ func Slice[S ~[]T, T any](elemCmp Comparer[T]) Comparer[S] {
 	}
 }
 
+// Map compares two maps with the given key and value comparers.
+//
+// The maps are compared as if they were slices of key/value pairs
+// sorted by key. The first pair with a different key or value determines
+// the result, otherwise the map with fewer entries is less.
+func Map[M ~map[K]V, K comparable, V any](keyCmp Comparer[K], valueCmp Comparer[V]) Comparer[M] {
+	return func(a, b M) int {
+		ka, kb := sortedKeys(a, keyCmp), sortedKeys(b, keyCmp)
+		ca, cb := len(ka), len(kb)
+		cMin := min(ca, cb)
+		for i := 0; i < cMin; i++ {
+			if cmp := keyCmp(ka[i], kb[i]); cmp != 0 {
+				return cmp
+			}
+			if cmp := valueCmp(a[ka[i]], b[kb[i]]); cmp != 0 {
+				return cmp
+			}
+		}
+		return cmp.Compare(ca, cb)
+	}
+}
+
+// sortedKeys gets the keys of the given map sorted by the given comparer.
+func sortedKeys[M ~map[K]V, K comparable, V any](m M, keyCmp Comparer[K]) []K {
+	keys := liteUtils.Keys(m)
+	slices.SortFunc(keys, keyCmp)
+	return keys
+}
+
+// By returns a comparer which compares the keys selected from two values.
+// This is designed to compare structures by one of their fields,
+// e.g. `comp.By(func(p Person) string { return p.Name })`.
+//
+// An optional comparer may be given to compare the keys.
+// If no key comparer is given, the default comparer for the key type is used.
+// This will panic if no key comparer is given and there is no default
+// comparer for the key type.
+func By[T, K any](selector func(value T) K, keyComparer ...Comparer[K]) Comparer[T] {
+	if liteUtils.IsNil(selector) {
+		panic(terror.NilArg(`selector`))
+	}
+	keyCmp := optionalComparer(keyComparer)
+	return func(x, y T) int {
+		return keyCmp(selector(x), selector(y))
+	}
+}
+
+// optionalComparer gets the one optional comparer or the default comparer.
+// This will panic if more than one comparer is given or if
+// no comparer is given and there is no default comparer for the type.
+func optionalComparer[T any](comparer []Comparer[T]) Comparer[T] {
+	if count := len(comparer); count > 0 {
+		if count > 1 {
+			panic(terror.InvalidArgCount(1, count, `comparer`))
+		}
+		if c := comparer[0]; !liteUtils.IsNil(c) {
+			return c
+		}
+	}
+	if c := Default[T](); !liteUtils.IsNil(c) {
+		return c
+	}
+	panic(terror.New(`must provide a comparer to compare this type`).
+		With(`type`, reflect.TypeFor[T]()))
+}
+
+// NilsFirst returns a comparer where nil values are less than any non-nil
+// value, and two nil values are equal. Values which are both not nil are
+// compared with the given comparer. This is designed for pointer and
+// interface types so that the given comparer never receives a nil value.
+func NilsFirst[T any](cmp Comparer[T]) Comparer[T] {
+	return nils(cmp, -1)
+}
+
+// NilsLast returns a comparer where nil values are greater than any non-nil
+// value, and two nil values are equal. Values which are both not nil are
+// compared with the given comparer. This is designed for pointer and
+// interface types so that the given comparer never receives a nil value.
+func NilsLast[T any](cmp Comparer[T]) Comparer[T] {
+	return nils(cmp, 1)
+}
+
+// nils returns a comparer which returns the given result
+// when only the first value is nil.
+func nils[T any](cmp Comparer[T], nilResult int) Comparer[T] {
+	if liteUtils.IsNil(cmp) {
+		panic(terror.NilArg(`cmp`))
+	}
+	return func(x, y T) int {
+		xNil, yNil := liteUtils.IsNil(x), liteUtils.IsNil(y)
+		switch {
+		case xNil && yNil:
+			return 0
+		case xNil:
+			return nilResult
+		case yNil:
+			return -nilResult
+		default:
+			return cmp(x, y)
+		}
+	}
+}
+
 // Or will return the first non-zero value returned
 // by a comparison or it will return zero.
 //
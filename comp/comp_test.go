@@ -1,6 +1,7 @@
 package comp
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"slices"
@@ -452,3 +453,71 @@ func Test_Comp_Or(t *testing.T) {
 		`Kim Hicks 25`,
 		`Bob Smith 54`)
 }
+
+func Test_Comp_By(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	ann, bob, cat := person{`Ann`, 40}, person{`Bob`, 25}, person{`Cat`, 40}
+
+	byAge := By(func(p person) int { return p.age })
+	checkComparer(t, byAge, ann, bob, 1)
+	checkComparer(t, byAge, bob, ann, -1)
+	checkComparer(t, byAge, ann, cat, 0)
+
+	byName := By(func(p person) string { return p.name }, Ordered[string]().Reverse())
+	checkComparer(t, byName, ann, bob, 1)
+
+	checkComparer(t, byAge.ThenBy(byName), ann, cat, 1)
+	checkComparer(t, byAge.Reverse().ThenBy(byName.Reverse()), bob, cat, 1)
+	checkComparer(t, byAge.ThenBy(), ann, cat, 0)
+
+	checkPanic(t, `must provide a comparer to compare this type {type: comp.person}`, func() {
+		By(func(p person) person { return p })
+	})
+	checkPanic(t, `invalid number of arguments {count: 2, maximum: 1, usage: comparer}`, func() {
+		By(func(p person) int { return p.age }, Ordered[int](), Ordered[int]())
+	})
+	checkPanic(t, `argument may not be nil {name: selector}`, func() {
+		By[person, int](nil)
+	})
+	checkPanic(t, `may not chain comparisons onto a nil comparer`, func() {
+		Comparer[person](nil).ThenBy(byAge)
+	})
+	checkPanic(t, `argument may not be nil {index: 1, name: next}`, func() {
+		byAge.ThenBy(byName, nil)
+	})
+}
+
+func Test_Comp_Nils(t *testing.T) {
+	one, two := 1, 2
+	byValue := func(x, y *int) int { return cmp.Compare(*x, *y) }
+
+	first := NilsFirst(byValue)
+	checkComparer(t, first, nil, nil, 0)
+	checkComparer(t, first, nil, &one, -1)
+	checkComparer(t, first, &one, nil, 1)
+	checkComparer(t, first, &one, &two, -1)
+
+	last := NilsLast(byValue)
+	checkComparer(t, last, nil, nil, 0)
+	checkComparer(t, last, nil, &one, 1)
+	checkComparer(t, last, &one, nil, -1)
+	checkComparer(t, last, &two, &one, 1)
+
+	checkPanic(t, `argument may not be nil {name: cmp}`, func() {
+		NilsFirst[*int](nil)
+	})
+}
+
+func Test_Comp_Map(t *testing.T) {
+	c := Map[map[string]int](Ordered[string](), Ordered[int]())
+	checkComparer(t, c, map[string]int{}, map[string]int{}, 0)
+	checkComparer(t, c, nil, map[string]int{}, 0)
+	checkComparer(t, c, map[string]int{`a`: 1, `b`: 2}, map[string]int{`b`: 2, `a`: 1}, 0)
+	checkComparer(t, c, map[string]int{`a`: 1, `b`: 2}, map[string]int{`a`: 1, `b`: 3}, -1)
+	checkComparer(t, c, map[string]int{`a`: 1, `c`: 2}, map[string]int{`a`: 1, `b`: 2}, 1)
+	checkComparer(t, c, map[string]int{`a`: 1}, map[string]int{`a`: 1, `b`: 2}, -1)
+	checkComparer(t, c, map[string]int{`b`: 1}, map[string]int{`a`: 1, `b`: 2}, 1)
+}
@@ -42,3 +42,28 @@ func (cmp Comparer[T]) ToLess() IsLessThan[T] {
 		return cmp(x, y) < 0
 	}
 }
+
+// ThenBy gets a comparer which uses this comparer first, then if the values
+// are equal, uses each of the given comparers in order until one of them
+// finds the values are not equal. This is designed to compare by several keys,
+// e.g. `comp.By(lastName).ThenBy(comp.By(firstName))`.
+func (cmp Comparer[T]) ThenBy(next ...Comparer[T]) Comparer[T] {
+	if liteUtils.IsNil(cmp) {
+		panic(terror.New(`may not chain comparisons onto a nil comparer`))
+	}
+	for i, c := range next {
+		if liteUtils.IsNil(c) {
+			panic(terror.NilArg(`next`).
+				With(`index`, i))
+		}
+	}
+	comps := append([]Comparer[T]{cmp}, next...)
+	return func(x, y T) int {
+		for _, c := range comps {
+			if result := c(x, y); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}
+}
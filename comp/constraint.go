@@ -0,0 +1,211 @@
+package comp
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// VersionConstraint is a parsed version constraint, e.g. `>=1.2 <2.0`,
+// which determines if versions satisfy it.
+type VersionConstraint interface {
+	// Satisfies determines if the given version satisfies the constraint.
+	// Returns an error if the given version isn't valid.
+	Satisfies(version string) (bool, error)
+
+	// String gets the constraint which was parsed.
+	String() string
+}
+
+// versionOperators are the operators for comparing a version
+// to a bound, ordered so that longer operators are checked first.
+var versionOperators = []string{`>=`, `<=`, `!=`, `>`, `<`, `=`}
+
+// versionBound is a single comparison in a version constraint.
+type versionBound[T any] struct {
+	op      string
+	version T
+}
+
+// versionConstraintImp is a version constraint for versions of type T.
+type versionConstraintImp[T any] struct {
+	constraint string
+	ranges     [][]versionBound[T]
+	parse      func(s string) (T, error)
+	compare    func(x, y T) int
+
+	// belowBound determines if a version which is less than the bound
+	// is still excluded by a `<` comparison, or nil if none are excluded.
+	belowBound func(v, bound T) bool
+}
+
+// ParseSemVerConstraint parses a constraint for semantic versions,
+// see `ParseVersion`.
+//
+// A constraint is one or more comparisons separated by whitespace which
+// must all be satisfied, e.g. `>=1.2 <2.0`. Each comparison is an
+// operator, `=`, `!=`, `>`, `>=`, `<`, or `<=`, followed by a version.
+// If the operator is omitted then `=` is used. Several ranges may be
+// separated by `||` where any one of the ranges must be satisfied,
+// e.g. `<1.0 || >=1.4 <2.0`. Versions are compared by precedence.
+//
+// Versions in the constraint may omit the minor and patch numbers,
+// which are treated as zero, e.g. `>=1.2` is the same as `>=1.2.0`.
+// A `<` comparison with a version without pre-release identifiers also
+// excludes the pre-releases of that version, e.g. `2.0.0-rc.1` doesn't
+// satisfy `<2.0`. Versions which aren't valid semantic versions never
+// satisfy the constraint. This returns an error if the constraint is invalid.
+func ParseSemVerConstraint(constraint string) (VersionConstraint, error) {
+	return parseVersionConstraint(constraint, parsePartialVersion,
+		ParseVersion, Version.CompareTo, isPreReleaseOf)
+}
+
+// ParseDottedConstraint parses a constraint for dotted numeric identifiers,
+// see `ParseDotted`. The constraint is the same as for `ParseSemVerConstraint`,
+// e.g. `>=1.2 <2.0`. The identifiers are compared the same as `Dotted`
+// except that trailing zero parts are ignored, e.g. `1.3.0` satisfies `<=1.3`.
+//
+// Values which aren't valid dotted identifiers never satisfy the constraint.
+// This returns an error if the constraint is invalid.
+func ParseDottedConstraint(constraint string) (VersionConstraint, error) {
+	return parseVersionConstraint(constraint, ParseDotted,
+		ParseDotted, compareTrimmedDotted, nil)
+}
+
+// parsePartialVersion parses a semantic version where the
+// minor and patch numbers may be omitted, e.g. `1.2-rc.1`.
+func parsePartialVersion(s string) (Version, error) {
+	end := strings.IndexAny(s, `-+`)
+	if end < 0 {
+		end = len(s)
+	}
+	if dots := strings.Count(s[:end], `.`); dots < 2 {
+		s = s[:end] + strings.Repeat(`.0`, 2-dots) + s[end:]
+	}
+	return ParseVersion(s)
+}
+
+// isPreReleaseOf determines if the given version is a pre-release
+// of the given bound when the bound isn't a pre-release itself.
+func isPreReleaseOf(v, bound Version) bool {
+	return len(v.PreRelease) > 0 && len(bound.PreRelease) <= 0 &&
+		v.Major == bound.Major && v.Minor == bound.Minor && v.Patch == bound.Patch
+}
+
+// compareTrimmedDotted compares dotted numeric identifiers
+// with any trailing zero parts ignored.
+func compareTrimmedDotted(x, y []uint64) int {
+	return slices.Compare(trimZeros(x), trimZeros(y))
+}
+
+// trimZeros removes the trailing zero parts from the given numbers.
+func trimZeros(numbers []uint64) []uint64 {
+	end := len(numbers)
+	for end > 0 && numbers[end-1] == 0 {
+		end--
+	}
+	return numbers[:end]
+}
+
+// parseVersionConstraint parses the ranges, separated by `||`, in the
+// constraint. The bounds in the constraint are parsed with parseBound
+// and the versions being checked are parsed with parseValue.
+func parseVersionConstraint[T any](constraint string,
+	parseBound, parseValue func(s string) (T, error),
+	compare func(x, y T) int, belowBound func(v, bound T) bool,
+) (VersionConstraint, error) {
+	invalid := func(reason string) error {
+		return terror.New(`invalid version constraint`).
+			With(`constraint`, constraint).
+			With(`reason`, reason)
+	}
+
+	parts := strings.Split(constraint, `||`)
+	ranges := make([][]versionBound[T], len(parts))
+	for i, part := range parts {
+		tokens := strings.Fields(part)
+		if len(tokens) <= 0 {
+			return nil, invalid(`a range may not be empty`)
+		}
+		bounds := []versionBound[T]{}
+		for j := 0; j < len(tokens); j++ {
+			op, text := splitOperator(tokens[j])
+			if len(text) <= 0 && j+1 < len(tokens) {
+				j++
+				text = tokens[j]
+			}
+			if len(text) <= 0 {
+				return nil, invalid(`an operator must be followed by a version`)
+			}
+			version, err := parseBound(text)
+			if err != nil {
+				return nil, terror.New(`invalid version constraint`, err).
+					With(`constraint`, constraint)
+			}
+			bounds = append(bounds, versionBound[T]{op: op, version: version})
+		}
+		ranges[i] = bounds
+	}
+	return &versionConstraintImp[T]{
+		constraint: constraint,
+		ranges:     ranges,
+		parse:      parseValue,
+		compare:    compare,
+		belowBound: belowBound,
+	}, nil
+}
+
+// splitOperator splits the operator from the start of the given token.
+// If there is no operator then `=` is returned with the whole token.
+func splitOperator(token string) (string, string) {
+	for _, op := range versionOperators {
+		if rest, has := strings.CutPrefix(token, op); has {
+			return op, rest
+		}
+	}
+	return `=`, token
+}
+
+func (c *versionConstraintImp[T]) Satisfies(version string) (bool, error) {
+	v, err := c.parse(version)
+	if err != nil {
+		return false, err
+	}
+	for _, bounds := range c.ranges {
+		if c.satisfies(v, bounds) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// satisfies determines if the given version satisfies all the given bounds.
+func (c *versionConstraintImp[T]) satisfies(v T, bounds []versionBound[T]) bool {
+	for _, b := range bounds {
+		r := c.compare(v, b.version)
+		var ok bool
+		switch b.op {
+		case `=`:
+			ok = r == 0
+		case `!=`:
+			ok = r != 0
+		case `>`:
+			ok = r > 0
+		case `>=`:
+			ok = r >= 0
+		case `<`:
+			ok = r < 0 && (c.belowBound == nil || !c.belowBound(v, b.version))
+		default: // `<=`
+			ok = r <= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *versionConstraintImp[T]) String() string {
+	return c.constraint
+}
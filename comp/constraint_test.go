@@ -0,0 +1,59 @@
+package comp
+
+import "testing"
+
+func checkSatisfies(t *testing.T, c VersionConstraint, version string, exp bool) {
+	t.Helper()
+	ok, err := c.Satisfies(version)
+	if err != nil || ok != exp {
+		t.Errorf("\nUnexpected result from %q.Satisfies(%q):\n"+
+			"\tActual:   %t, %v\n"+
+			"\tExpected: %t", c.String(), version, ok, err, exp)
+	}
+}
+
+func Test_Comp_SemVerConstraint(t *testing.T) {
+	c, err := ParseSemVerConstraint(`>=1.2 <2.0`)
+	checkEqual(t, nil, err, true)
+	checkEqual(t, `>=1.2 <2.0`, c.String(), true)
+	checkSatisfies(t, c, `1.1.9`, false)
+	checkSatisfies(t, c, `1.2.0`, true)
+	checkSatisfies(t, c, `1.9.9-rc.1`, true)
+	checkSatisfies(t, c, `2.0.0-rc.1`, false)
+	checkSatisfies(t, c, `2.0.0`, false)
+
+	c, _ = ParseSemVerConstraint(`<2.0.0-rc.2 || <=1.0`)
+	checkSatisfies(t, c, `2.0.0-rc.1`, true)
+	checkSatisfies(t, c, `2.0.0-rc.2`, false)
+	checkSatisfies(t, c, `1.0.0-rc.1`, true)
+
+	_, err = c.Satisfies(`1.4`)
+	if err == nil || err.Error() != `invalid semantic version {reason: must have major, minor, and patch numbers, version: 1.4}` {
+		t.Errorf("Unexpected error from Satisfies: %v", err)
+	}
+	_, err = ParseSemVerConstraint(`>=1.2 ||`)
+	if err == nil || err.Error() != `invalid version constraint {constraint: >=1.2 ||, reason: a range may not be empty}` {
+		t.Errorf("Unexpected error from ParseSemVerConstraint: %v", err)
+	}
+}
+
+func Test_Comp_DottedConstraint(t *testing.T) {
+	c, err := ParseDottedConstraint(`>1.2.9 <=1.3`)
+	checkEqual(t, nil, err, true)
+	checkSatisfies(t, c, `1.2.9`, false)
+	checkSatisfies(t, c, `1.2.9.0`, false)
+	checkSatisfies(t, c, `1.2.10`, true)
+	checkSatisfies(t, c, `1.3`, true)
+	checkSatisfies(t, c, `1.3.0.0`, true)
+	checkSatisfies(t, c, `1.3.0.1`, false)
+
+	c, _ = ParseDottedConstraint(`=0`)
+	checkSatisfies(t, c, `0.0`, true)
+	checkSatisfies(t, c, `0.1`, false)
+
+	_, err = ParseDottedConstraint(`>=1..2`)
+	if err == nil || err.Error() != `invalid version constraint {constraint: >=1..2}: `+
+		`invalid dotted identifier {identifier: 1..2, reason: a number may not be empty}` {
+		t.Errorf("Unexpected error from ParseDottedConstraint: %v", err)
+	}
+}
@@ -0,0 +1,456 @@
+package comp
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"unsafe"
+)
+
+// DeepOption is an option for changing how `DeepEqual` compares values.
+type DeepOption func(opts *deepOptions)
+
+type deepOptions struct {
+	ignored        map[string]bool
+	nilEqualsEmpty bool
+	epsilon        float64
+	unordered      bool
+}
+
+// IgnoreFields is an option for `DeepEqual` which skips struct fields.
+// Each name may be a field name, e.g. `Updated`, which skips any field
+// with that name, or a path to a field, e.g. `Owner.Updated`, which only
+// skips that one field. Indices in a path are written in square brackets,
+// e.g. `Items[2].Updated`.
+func IgnoreFields(names ...string) DeepOption {
+	return func(opts *deepOptions) {
+		for _, name := range names {
+			opts.ignored[name] = true
+		}
+	}
+}
+
+// NilEqualsEmpty is an option for `DeepEqual` which treats
+// nil slices and maps as equal to empty slices and maps.
+func NilEqualsEmpty() DeepOption {
+	return func(opts *deepOptions) {
+		opts.nilEqualsEmpty = true
+	}
+}
+
+// FloatEpsilon is an option for `DeepEqual` which treats floating point
+// numbers as equal when they are within the given epsilon (inclusively).
+// The real and imaginary parts of complex numbers are compared separately.
+func FloatEpsilon(epsilon float64) DeepOption {
+	return func(opts *deepOptions) {
+		opts.epsilon = epsilon
+	}
+}
+
+// UnorderedSlices is an option for `DeepEqual` which treats slices
+// as equal if they contain equal values in any order.
+// Arrays are still compared in order.
+func UnorderedSlices() DeepOption {
+	return func(opts *deepOptions) {
+		opts.unordered = true
+	}
+}
+
+// DeepEqual determines if the two values are structurally equal
+// and returns the paths to where the values differ.
+//
+// Unlike `reflect.DeepEqual`, any value at any level that is `Equatable`
+// is compared with its `Equals` method. Pointers and interfaces are followed
+// and cycles are detected so that recursive values are compared safely.
+//
+// The paths are written like the paths for `predicate.Field`,
+// e.g. `Owner.Name`, `Items[2]`, or `Scores[math]`, and are sorted.
+// A difference between the two values themselves, such as when the values
+// are not the same type, has an empty path. When two slices have different
+// lengths the path for the slice is given, along with any differences
+// in the values both slices have.
+func DeepEqual[T any](a, b T, options ...DeepOption) (equal bool, diffs []string) {
+	d := &deepEqualer{
+		opts:    deepOptions{ignored: map[string]bool{}},
+		visited: map[deepVisit]bool{},
+	}
+	for _, option := range options {
+		option(&d.opts)
+	}
+	va, vb := reflect.ValueOf(&a).Elem(), reflect.ValueOf(&b).Elem()
+	d.compare(``, va, vb)
+	sort.Strings(d.diffs)
+	return len(d.diffs) == 0, d.diffs
+}
+
+// deepVisit is a pair of references which have been compared.
+type deepVisit struct {
+	a, b unsafe.Pointer
+	typ  reflect.Type
+}
+
+type deepEqualer struct {
+	opts    deepOptions
+	visited map[deepVisit]bool
+	diffs   []string
+
+	// quiet is used while matching unordered values so that
+	// the differences from trying values are not recorded.
+	quiet int
+}
+
+// differ records a difference at the given path and returns false.
+func (d *deepEqualer) differ(path string) bool {
+	if d.quiet <= 0 {
+		d.diffs = append(d.diffs, path)
+	}
+	return false
+}
+
+// exported gets the given value such that it can be used with `Interface`
+// even when it was reached through an unexported struct field, so that any
+// `Equatable` value in an unexported field is still compared with `Equals`.
+// Values which can't be made usable are returned unchanged.
+func exported(v reflect.Value) reflect.Value {
+	if !v.IsValid() || v.CanInterface() || !v.CanAddr() {
+		return v
+	}
+	return reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+}
+
+// addressable gets the given value such that it is addressable,
+// by copying the value when it isn't, so that the values of any
+// unexported fields in it can be made usable with `exported`.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() || !v.CanInterface() {
+		return v
+	}
+	c := reflect.New(v.Type()).Elem()
+	c.Set(v)
+	return c
+}
+
+// equatable gets the value as an `Equatable` if it is one.
+func equatable(v reflect.Value) (Equatable, bool) {
+	if !v.IsValid() || !v.CanInterface() {
+		return nil, false
+	}
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return nil, false
+		}
+	}
+	if e, ok := v.Interface().(Equatable); ok {
+		return e, true
+	}
+	if v.CanAddr() {
+		if e, ok := v.Addr().Interface().(Equatable); ok {
+			return e, true
+		}
+	}
+	return nil, false
+}
+
+// enter marks the given references as being compared. Returns true for seen
+// if they are already being compared further up, meaning there is a cycle.
+// The key must be removed from visited once the references have been compared.
+func (d *deepEqualer) enter(a, b reflect.Value) (key deepVisit, seen bool) {
+	key = deepVisit{
+		a:   a.UnsafePointer(),
+		b:   b.UnsafePointer(),
+		typ: a.Type(),
+	}
+	if d.visited[key] {
+		return key, true
+	}
+	d.visited[key] = true
+	return key, false
+}
+
+func (d *deepEqualer) compare(path string, a, b reflect.Value) bool {
+	a, b = exported(a), exported(b)
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() == b.IsValid() {
+			return true
+		}
+		return d.differ(path)
+	}
+	if ea, ok := equatable(a); ok && b.CanInterface() {
+		if ea.Equals(b.Interface()) {
+			return true
+		}
+		return d.differ(path)
+	}
+	if eb, ok := equatable(b); ok && a.CanInterface() {
+		if eb.Equals(a.Interface()) {
+			return true
+		}
+		return d.differ(path)
+	}
+	if a.Type() != b.Type() {
+		return d.differ(path)
+	}
+
+	switch a.Kind() {
+	case reflect.Pointer:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() == b.IsNil() {
+				return true
+			}
+			return d.differ(path)
+		}
+		if a.UnsafePointer() == b.UnsafePointer() {
+			return true
+		}
+		key, seen := d.enter(a, b)
+		if seen {
+			return true
+		}
+		defer delete(d.visited, key)
+		return d.compare(path, a.Elem(), b.Elem())
+
+	case reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() == b.IsNil() {
+				return true
+			}
+			return d.differ(path)
+		}
+		return d.compare(path, a.Elem(), b.Elem())
+
+	case reflect.Struct:
+		return d.compareStruct(path, a, b)
+
+	case reflect.Array:
+		return d.compareOrdered(path, a, b)
+
+	case reflect.Slice:
+		if equal, done := d.compareNils(path, a, b); done {
+			return equal
+		}
+		if a.UnsafePointer() == b.UnsafePointer() && a.Len() == b.Len() {
+			return true
+		}
+		key, seen := d.enter(a, b)
+		if seen {
+			return true
+		}
+		defer delete(d.visited, key)
+		if d.opts.unordered {
+			return d.compareUnordered(path, a, b)
+		}
+		return d.compareOrdered(path, a, b)
+
+	case reflect.Map:
+		if equal, done := d.compareNils(path, a, b); done {
+			return equal
+		}
+		if a.UnsafePointer() == b.UnsafePointer() {
+			return true
+		}
+		key, seen := d.enter(a, b)
+		if seen {
+			return true
+		}
+		defer delete(d.visited, key)
+		return d.compareMap(path, a, b)
+
+	case reflect.Float32, reflect.Float64:
+		if d.floatEqual(a.Float(), b.Float()) {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.Complex64, reflect.Complex128:
+		ca, cb := a.Complex(), b.Complex()
+		if d.floatEqual(real(ca), real(cb)) && d.floatEqual(imag(ca), imag(cb)) {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.Func:
+		if a.IsNil() && b.IsNil() {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.Bool:
+		if a.Bool() == b.Bool() {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if a.Int() == b.Int() {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if a.Uint() == b.Uint() {
+			return true
+		}
+		return d.differ(path)
+
+	case reflect.String:
+		if a.String() == b.String() {
+			return true
+		}
+		return d.differ(path)
+
+	default: // Chan and UnsafePointer
+		if a.UnsafePointer() == b.UnsafePointer() {
+			return true
+		}
+		return d.differ(path)
+	}
+}
+
+// compareNils compares two slices or maps when either is nil.
+// Returns true for done if either is nil.
+func (d *deepEqualer) compareNils(path string, a, b reflect.Value) (equal, done bool) {
+	if !a.IsNil() && !b.IsNil() {
+		return false, false
+	}
+	if a.IsNil() == b.IsNil() || (d.opts.nilEqualsEmpty && a.Len() == 0 && b.Len() == 0) {
+		return true, true
+	}
+	return d.differ(path), true
+}
+
+func (d *deepEqualer) floatEqual(a, b float64) bool {
+	return a == b || math.Abs(a-b) <= d.opts.epsilon
+}
+
+func (d *deepEqualer) compareStruct(path string, a, b reflect.Value) bool {
+	equal := true
+	a, b = addressable(a), addressable(b)
+	typ := a.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		name := typ.Field(i).Name
+		fieldPath := joinField(path, name)
+		if d.opts.ignored[name] || d.opts.ignored[fieldPath] {
+			continue
+		}
+		if !d.compare(fieldPath, a.Field(i), b.Field(i)) {
+			equal = false
+		}
+	}
+	return equal
+}
+
+func (d *deepEqualer) compareOrdered(path string, a, b reflect.Value) bool {
+	equal := true
+	if a.Len() != b.Len() {
+		equal = d.differ(path)
+	}
+	count := min(a.Len(), b.Len())
+	for i := 0; i < count; i++ {
+		if !d.compare(fmt.Sprintf(`%s[%d]`, path, i), a.Index(i), b.Index(i)) {
+			equal = false
+		}
+	}
+	return equal
+}
+
+// compareUnordered compares two slices by matching each value in the first
+// slice to a different equal value in the second slice. Since values may
+// be equal to several others, e.g. when using `FloatEpsilon`, the values are
+// matched by finding a maximum matching between the two slices.
+// The path for each value in the first slice without a match is recorded.
+func (d *deepEqualer) compareUnordered(path string, a, b reflect.Value) bool {
+	equal := true
+	if a.Len() != b.Len() {
+		equal = d.differ(path)
+	}
+
+	// The results of comparing values are kept since the
+	// matching may need to compare the same values again.
+	const unknown, same, different = 0, 1, 2
+	results := make([][]int8, a.Len())
+	for i := range results {
+		results[i] = make([]int8, b.Len())
+	}
+	equals := func(i, j int) bool {
+		if results[i][j] == unknown {
+			d.quiet++
+			results[i][j] = different
+			if d.compare(path, a.Index(i), b.Index(j)) {
+				results[i][j] = same
+			}
+			d.quiet--
+		}
+		return results[i][j] == same
+	}
+
+	// match tries to match the value at i in the first slice to a value
+	// in the second slice, moving previously matched values to other
+	// values in the second slice if needed to free up a value.
+	matches := make([]int, b.Len())
+	for j := range matches {
+		matches[j] = -1
+	}
+	var visited []bool
+	var match func(i int) bool
+	match = func(i int) bool {
+		for j := range matches {
+			if !visited[j] && equals(i, j) {
+				visited[j] = true
+				if matches[j] < 0 || match(matches[j]) {
+					matches[j] = i
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < a.Len(); i++ {
+		visited = make([]bool, b.Len())
+		if !match(i) {
+			equal = d.differ(fmt.Sprintf(`%s[%d]`, path, i))
+		}
+	}
+	return equal
+}
+
+func (d *deepEqualer) compareMap(path string, a, b reflect.Value) bool {
+	equal := true
+	for _, key := range a.MapKeys() {
+		keyPath := path + `[` + keyString(key) + `]`
+		bv := b.MapIndex(key)
+		if !bv.IsValid() {
+			equal = d.differ(keyPath)
+			continue
+		}
+		if !d.compare(keyPath, a.MapIndex(key), bv) {
+			equal = false
+		}
+	}
+	for _, key := range b.MapKeys() {
+		if !a.MapIndex(key).IsValid() {
+			equal = d.differ(path + `[` + keyString(key) + `]`)
+		}
+	}
+	return equal
+}
+
+// joinField adds the given field name to the given path.
+func joinField(path, name string) string {
+	if len(path) <= 0 {
+		return name
+	}
+	return path + `.` + name
+}
+
+// keyString gets the string for a map key in a path.
+func keyString(key reflect.Value) string {
+	str := fmt.Sprint(key)
+	if strings.ContainsAny(str, `.[]"`) {
+		return fmt.Sprintf(`%q`, str)
+	}
+	return str
+}
@@ -0,0 +1,197 @@
+package comp
+
+import (
+	"strings"
+	"testing"
+)
+
+type (
+	deepItem struct {
+		Name    string
+		Price   float64
+		Tags    []string
+		Updated int
+	}
+
+	deepOrder struct {
+		ID      int
+		Items   []deepItem
+		Counts  map[string]int
+		Owner   *deepOwner
+		Extra   any
+		Updated int
+		secret  string
+	}
+
+	deepOwner struct {
+		Name    string
+		Manager *deepOwner
+	}
+
+	// deepFolded is equal to any other deepFolded
+	// which has the same text ignoring case.
+	deepFolded struct{ text string }
+
+	// deepHidden has an `Equatable` in an unexported field.
+	deepHidden struct{ folded deepFolded }
+)
+
+func (f deepFolded) Equals(other any) bool {
+	o, ok := other.(deepFolded)
+	return ok && strings.EqualFold(f.text, o.text)
+}
+
+func newDeepOrder() deepOrder {
+	return deepOrder{
+		ID: 7,
+		Items: []deepItem{
+			{Name: `pen`, Price: 1.5, Tags: []string{`office`}},
+			{Name: `ink`, Price: 4.25},
+		},
+		Counts: map[string]int{`pen`: 2, `ink`: 1},
+		Owner:  &deepOwner{Name: `Ann`},
+		secret: `x`,
+	}
+}
+
+func checkDeepEqual[T any](t *testing.T, a, b T, expEqual bool, expDiffs []string, options ...DeepOption) {
+	t.Helper()
+	equal, diffs := DeepEqual(a, b, options...)
+	if equal != expEqual || strings.Join(diffs, `|`) != strings.Join(expDiffs, `|`) {
+		t.Errorf("\nUnexpected result from DeepEqual:\n"+
+			"\tActual:   %t %q\n"+
+			"\tExpected: %t %q", equal, diffs, expEqual, expDiffs)
+	}
+}
+
+func Test_Comp_DeepEqual(t *testing.T) {
+	checkDeepEqual(t, newDeepOrder(), newDeepOrder(), true, nil)
+	checkDeepEqual(t, 1, 1, true, nil)
+	checkDeepEqual(t, 1, 2, false, []string{``})
+	checkDeepEqual[any](t, 1, `1`, false, []string{``})
+	checkDeepEqual[any](t, nil, nil, true, nil)
+	checkDeepEqual[any](t, nil, 0, false, []string{``})
+
+	a, b := newDeepOrder(), newDeepOrder()
+	b.Items[1].Price = 4.5
+	b.Items[0].Tags = append(b.Items[0].Tags, `gift`)
+	b.Counts[`pen`] = 3
+	delete(b.Counts, `ink`)
+	b.Counts[`a.b`] = 1
+	b.Owner.Name = `Bob`
+	b.Extra = []int{1}
+	b.secret = `y`
+	checkDeepEqual(t, a, b, false, []string{
+		`Counts["a.b"]`,
+		`Counts[ink]`,
+		`Counts[pen]`,
+		`Extra`,
+		`Items[0].Tags`,
+		`Items[1].Price`,
+		`Owner.Name`,
+		`secret`,
+	})
+
+	b = newDeepOrder()
+	b.Items = b.Items[:1]
+	b.Owner = nil
+	checkDeepEqual(t, a, b, false, []string{`Items`, `Owner`})
+
+	arrA, arrB := [3]int{1, 2, 3}, [3]int{1, 5, 3}
+	checkDeepEqual(t, arrA, arrB, false, []string{`[1]`})
+	checkDeepEqual(t, &arrA, &arrB, false, []string{`[1]`})
+	checkDeepEqual(t, &arrA, &arrA, true, nil)
+	checkDeepEqual(t, 1+2i, 1+2i, true, nil)
+	checkDeepEqual(t, 1+2i, 1+3i, false, []string{``})
+
+	var nilFunc func()
+	checkDeepEqual(t, nilFunc, nil, true, nil)
+	checkDeepEqual(t, func() {}, func() {}, false, []string{``})
+}
+
+func Test_Comp_DeepEqual_Equatable(t *testing.T) {
+	checkDeepEqual(t, deepFolded{`Hi`}, deepFolded{`HI`}, true, nil)
+	checkDeepEqual(t,
+		map[string][]any{`x`: {1, deepFolded{`abc`}}},
+		map[string][]any{`x`: {1, deepFolded{`ABC`}}},
+		true, nil)
+	checkDeepEqual(t,
+		[]deepFolded{{`abc`}, {`def`}},
+		[]deepFolded{{`ABC`}, {`xyz`}},
+		false, []string{`[1]`})
+
+	// Pointer receivers are used when the value is addressable.
+	p1, p2 := &pseudoEquatable{success: true}, &pseudoEquatable{success: false}
+	checkDeepEqual(t, []*pseudoEquatable{p1}, []*pseudoEquatable{p2}, true, nil)
+	checkDeepEqual(t, []*pseudoEquatable{p2}, []*pseudoEquatable{p1}, false, []string{`[0]`})
+	checkDeepEqual(t, pseudoEquatable{success: true}, pseudoEquatable{}, true, nil)
+
+	// Equatable values in unexported fields are still used.
+	checkDeepEqual(t, deepHidden{deepFolded{`Hi`}}, deepHidden{deepFolded{`HI`}}, true, nil)
+	checkDeepEqual(t, deepHidden{deepFolded{`Hi`}}, deepHidden{deepFolded{`Ho`}}, false, []string{`folded`})
+	checkDeepEqual(t,
+		map[string]deepHidden{`x`: {deepFolded{`abc`}}},
+		map[string]deepHidden{`x`: {deepFolded{`ABC`}}},
+		true, nil)
+	checkDeepEqual[any](t,
+		&deepHidden{deepFolded{`abc`}},
+		&deepHidden{deepFolded{`ABC`}},
+		true, nil)
+}
+
+func Test_Comp_DeepEqual_Cycles(t *testing.T) {
+	a := &deepOwner{Name: `Ann`}
+	a.Manager = a
+	b := &deepOwner{Name: `Ann`}
+	b.Manager = &deepOwner{Name: `Ann`, Manager: b}
+	checkDeepEqual(t, a, b, true, nil)
+
+	b.Manager.Name = `Bob`
+	checkDeepEqual(t, a, b, false, []string{`Manager.Name`})
+
+	s1, s2 := []any{1, nil}, []any{1, nil}
+	s1[1], s2[1] = s1, s2
+	checkDeepEqual(t, s1, s2, true, nil)
+
+	m1, m2 := map[string]any{}, map[string]any{}
+	m1[`self`], m2[`self`] = m1, m2
+	checkDeepEqual(t, m1, m2, true, nil)
+}
+
+func Test_Comp_DeepEqual_Options(t *testing.T) {
+	a, b := newDeepOrder(), newDeepOrder()
+	a.Updated, b.Updated = 1, 2
+	a.Items[0].Updated, b.Items[0].Updated = 3, 4
+	checkDeepEqual(t, a, b, false, []string{`Items[0].Updated`, `Updated`})
+	checkDeepEqual(t, a, b, true, nil, IgnoreFields(`Updated`))
+	checkDeepEqual(t, a, b, false, []string{`Items[0].Updated`, `Updated`}, IgnoreFields(`ID`, `Owner.Updated`))
+	checkDeepEqual(t, a, b, false, []string{`Updated`}, IgnoreFields(`Items[0].Updated`))
+
+	a, b = newDeepOrder(), newDeepOrder()
+	a.Items[1].Tags = []string{}
+	b.Counts = map[string]int{}
+	a.Counts = nil
+	checkDeepEqual(t, a, b, false, []string{`Counts`, `Items[1].Tags`})
+	checkDeepEqual(t, a, b, true, nil, NilEqualsEmpty())
+	checkDeepEqual(t, []int(nil), []int{1}, false, []string{``}, NilEqualsEmpty())
+
+	checkDeepEqual(t, []float64{1.0, 2.0}, []float64{1.05, 1.98}, false, []string{`[0]`, `[1]`})
+	checkDeepEqual(t, []float64{1.0, 2.0}, []float64{1.05, 1.98}, true, nil, FloatEpsilon(0.1))
+	checkDeepEqual(t, complex(1, 1), complex(1.01, 0.99), true, nil, FloatEpsilon(0.1))
+	checkDeepEqual(t, []float32{1.0}, []float32{1.5}, false, []string{`[0]`}, FloatEpsilon(0.1))
+
+	checkDeepEqual(t, []int{1, 2, 3}, []int{3, 1, 2}, false, []string{`[0]`, `[1]`, `[2]`})
+	checkDeepEqual(t, []int{1, 2, 3}, []int{3, 1, 2}, true, nil, UnorderedSlices())
+	checkDeepEqual(t, []int{1, 2, 2}, []int{2, 1, 1}, false, []string{`[2]`}, UnorderedSlices())
+	checkDeepEqual(t, []int{1, 2}, []int{2, 1, 1}, false, []string{``}, UnorderedSlices())
+	checkDeepEqual(t, [2]int{1, 2}, [2]int{2, 1}, false, []string{`[0]`, `[1]`}, UnorderedSlices())
+	checkDeepEqual(t,
+		[]deepItem{{Name: `a`, Price: 1}, {Name: `b`, Price: 2}},
+		[]deepItem{{Name: `b`, Price: 2.01}, {Name: `a`, Price: 0.99}},
+		true, nil, UnorderedSlices(), FloatEpsilon(0.02))
+
+	// The first value could match either value so it
+	// must not take the only match for the second value.
+	checkDeepEqual(t, []float64{1.25, 1.0}, []float64{1.0, 1.5}, true, nil, UnorderedSlices(), FloatEpsilon(0.25))
+	checkDeepEqual(t, []float64{1.25, 1.0, 2.0}, []float64{1.0, 1.5, 1.5}, false, []string{`[2]`}, UnorderedSlices(), FloatEpsilon(0.25))
+}
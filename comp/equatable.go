@@ -12,7 +12,8 @@ type Equatable interface {
 //
 // This will check if the objects are Equatable, otherwise it will fallback
 // to a DeepEqual. This will not check for Equatable within a slice, array,
-// map, etc only in the top level object.
+// map, etc only in the top level object. Use `DeepEqual` to check for
+// Equatable at every level.
 //
 // This will not check for Comparable types. Any struct that implements
 // Comparable should also implement Equatable where both agree.
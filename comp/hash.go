@@ -0,0 +1,194 @@
+package comp
+
+import (
+	"hash/maphash"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// Hashable is an object which can get a hash for itself.
+//
+// Any two objects which are equal, see `Equatable`, must have the same hash.
+// Two objects with the same hash may or may not be equal.
+type Hashable interface {
+	// Hash gets the hash for this object.
+	Hash() uint64
+}
+
+// cycleHash is the hash for a reference which is already being hashed
+// further up in the value, so that cyclic values don't recurse forever.
+const cycleHash = 0x2545f4914f6cdd1d
+
+// hashSeed is the seed for hashing strings,
+// it is only the same for the lifetime of the process.
+var hashSeed = maphash.MakeSeed()
+
+// Hash gets a hash for the given value which agrees with `Equal`,
+// meaning that if two values are equal they will have the same hash.
+// The hash is only the same for the lifetime of the process.
+//
+// If the value is `Hashable` then its hash is used. Otherwise, if the value is
+// `Equatable` then zero is returned since the value may equal any other value.
+// Otherwise the value's structure is hashed, following pointers,
+// the same as `reflect.DeepEqual` compares values.
+func Hash[T any](value T) uint64 {
+	h := &hasher{}
+	return h.hash(reflect.ValueOf(&value).Elem())
+}
+
+// HashCombine combines the given hash with the next hash in a sequence.
+// The result depends on the order the hashes are combined in.
+func HashCombine(hash, next uint64) uint64 {
+	return hash ^ (hashMix(next) + 0x9e3779b97f4a7c15 + (hash << 6) + (hash >> 2))
+}
+
+// hashMix spreads the bits of the given value, the finalizer from SplitMix64.
+func hashMix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// hashVisit is a reference which is being hashed.
+type hashVisit struct {
+	ptr unsafe.Pointer
+	typ reflect.Type
+}
+
+type hasher struct {
+	visited map[hashVisit]bool
+}
+
+// enter marks the given reference as being hashed. Returns true for seen
+// if it is already being hashed further up, meaning there is a cycle.
+// The key must be removed from visited once the reference has been hashed.
+func (h *hasher) enter(v reflect.Value) (key hashVisit, seen bool) {
+	key = hashVisit{
+		ptr: v.UnsafePointer(),
+		typ: v.Type(),
+	}
+	if h.visited == nil {
+		h.visited = map[hashVisit]bool{}
+	} else if h.visited[key] {
+		return key, true
+	}
+	h.visited[key] = true
+	return key, false
+}
+
+func (h *hasher) hash(v reflect.Value) uint64 {
+	if !v.IsValid() {
+		return 0
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		if v.IsNil() {
+			return 0
+		}
+	}
+	if v.CanInterface() {
+		switch t := v.Interface().(type) {
+		case Hashable:
+			return t.Hash()
+		case Equatable:
+			return 0
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		key, seen := h.enter(v)
+		if seen {
+			return cycleHash
+		}
+		defer delete(h.visited, key)
+		return h.hash(v.Elem())
+
+	case reflect.Interface:
+		return h.hash(v.Elem())
+
+	case reflect.Bool:
+		if v.Bool() {
+			return hashMix(1)
+		}
+		return hashMix(0)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return hashMix(uint64(v.Int()))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return hashMix(v.Uint())
+
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return HashCombine(hashFloat(real(c)), hashFloat(imag(c)))
+
+	case reflect.String:
+		return maphash.String(hashSeed, v.String())
+
+	case reflect.Slice:
+		if v.Len() > 0 {
+			key, seen := h.enter(v)
+			if seen {
+				return cycleHash
+			}
+			defer delete(h.visited, key)
+		}
+		return h.hashElems(v)
+
+	case reflect.Array:
+		return h.hashElems(v)
+
+	case reflect.Map:
+		key, seen := h.enter(v)
+		if seen {
+			return cycleHash
+		}
+		defer delete(h.visited, key)
+
+		// The entries are summed so that the order doesn't matter.
+		hash := hashMix(uint64(v.Len()))
+		it := v.MapRange()
+		for it.Next() {
+			entry := HashCombine(h.hash(it.Key()), h.hash(it.Value()))
+			hash += hashMix(entry)
+		}
+		return hash
+
+	case reflect.Struct:
+		hash := hashMix(uint64(v.NumField()))
+		for i := 0; i < v.NumField(); i++ {
+			hash = HashCombine(hash, h.hash(v.Field(i)))
+		}
+		return hash
+
+	default: // Func, Chan, and UnsafePointer
+		return hashMix(uint64(v.Pointer()))
+	}
+}
+
+// hashElems gets the hash for the elements of an array or slice.
+func (h *hasher) hashElems(v reflect.Value) uint64 {
+	hash := hashMix(uint64(v.Len()))
+	for i := 0; i < v.Len(); i++ {
+		hash = HashCombine(hash, h.hash(v.Index(i)))
+	}
+	return hash
+}
+
+// hashFloat gets the hash of a float where negative zero
+// has the same hash as zero since they are equal.
+func hashFloat(f float64) uint64 {
+	if f == 0 {
+		return hashMix(0)
+	}
+	return hashMix(math.Float64bits(f))
+}
@@ -0,0 +1,132 @@
+package comp
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+type pseudoHashable struct{ hash uint64 }
+
+func (h pseudoHashable) Hash() uint64 { return h.hash }
+
+func checkHash[T any](t *testing.T, a, b T, expSame bool) {
+	t.Helper()
+	ha, hb := Hash(a), Hash(b)
+	if (ha == hb) != expSame {
+		t.Errorf("\nUnexpected hashes:\n"+
+			"\tFirst:     %v => %d\n"+
+			"\tSecond:    %v => %d\n"+
+			"\tExpected same: %t", a, ha, b, hb, expSame)
+	}
+}
+
+func Test_Comp_Hash(t *testing.T) {
+	checkHash(t, 12, 12, true)
+	checkHash(t, 12, 13, false)
+	checkHash(t, `cat`, `cat`, true)
+	checkHash(t, `cat`, `act`, false)
+	checkHash(t, 1.5, 1.5, true)
+	checkHash(t, 0.0, math.Copysign(0, -1), true)
+	checkHash(t, 1+2i, 1+2i, true)
+	checkHash(t, true, false, false)
+	checkHash(t, []int{1, 2, 3}, []int{1, 2, 3}, true)
+	checkHash(t, []int{1, 2, 3}, []int{3, 2, 1}, false)
+	checkHash(t, []int{1, 2}, []int{1, 2, 0}, false)
+	checkHash(t, [2]string{`a`, `b`}, [2]string{`a`, `b`}, true)
+	checkHash(t, map[string]int{`a`: 1, `b`: 2}, map[string]int{`b`: 2, `a`: 1}, true)
+	checkHash(t, map[string]int{`a`: 1, `b`: 2}, map[string]int{`a`: 2, `b`: 1}, false)
+
+	one, other := 1, 1
+	checkHash(t, &one, &other, true)
+	checkHash[*int](t, nil, nil, true)
+	checkHash[any](t, nil, nil, true)
+
+	type pair struct {
+		Name  string
+		Value *float64
+		tags  []string
+	}
+	f1, f2 := 2.5, 2.5
+	checkHash(t, pair{`x`, &f1, []string{`a`}}, pair{`x`, &f2, []string{`a`}}, true)
+	checkHash(t, pair{`x`, &f1, []string{`a`}}, pair{`x`, &f2, []string{`b`}}, false)
+
+	checkHash(t, pseudoHashable{7}, pseudoHashable{7}, true)
+	checkHash(t, []any{pseudoHashable{7}}, []any{pseudoHashable{8}}, false)
+	if h := Hash(pseudoHashable{42}); h != 42 {
+		t.Errorf("Expected the hash from the hashable but got %d.", h)
+	}
+	checkHash(t, deepFolded{`A`}, deepFolded{`a`}, true)
+
+	type node struct {
+		Name string
+		Next *node
+	}
+	n := &node{Name: `loop`}
+	n.Next = n
+	checkHash(t, n, n, true)
+}
+
+func Test_Comp_Hash_Cyclic(t *testing.T) {
+	type node struct {
+		A, B *node
+		V    int
+	}
+	// Without tracking the nodes being hashed, this would recurse 2^64 times.
+	n1 := &node{V: 1}
+	n1.A, n1.B = n1, n1
+	n2 := &node{V: 1}
+	n2.A, n2.B = n2, n2
+	if Hash(n1) != Hash(n2) {
+		t.Errorf("Expected equal cyclic values to have the same hash.")
+	}
+	n2.V = 2
+	if Hash(n1) == Hash(n2) {
+		t.Errorf("Expected different cyclic values to have different hashes.")
+	}
+
+	m := map[string]any{}
+	m[`self`] = m
+	s := []any{nil}
+	s[0] = s
+	if Hash(m) == 0 || Hash(s) == 0 {
+		t.Errorf("Expected cyclic maps and slices to be hashed.")
+	}
+
+	// A value which is shared, but not cyclic, is hashed each time it is reached.
+	shared := &node{V: 3}
+	if Hash(node{A: shared, B: shared}) != Hash(node{A: &node{V: 3}, B: &node{V: 3}}) {
+		t.Errorf("Expected shared values to be hashed the same as copies.")
+	}
+}
+
+func Test_Comp_HashCombine(t *testing.T) {
+	a, b := Hash(`a`), Hash(`b`)
+	if HashCombine(HashCombine(0, a), b) == HashCombine(HashCombine(0, b), a) {
+		t.Errorf("Expected combining hashes to depend on the order.")
+	}
+}
+
+func Test_Comp_Hasher(t *testing.T) {
+	h := DefaultHasher[[]int]()
+	if !h.Equal([]int{1, 2}, []int{1, 2}) || h.Hash([]int{1, 2}) != h.Hash([]int{1, 2}) {
+		t.Errorf("Expected equal slices to be equal with the same hash.")
+	}
+	if h.Equal([]int{1, 2}, []int{2, 1}) {
+		t.Errorf("Expected different slices to not be equal.")
+	}
+
+	folded := NewHasher(func(value string) uint64 {
+		return Hash(strings.ToLower(value))
+	}, strings.EqualFold)
+	if !folded.Equal(`Cat`, `cAT`) || folded.Hash(`Cat`) != folded.Hash(`cAT`) {
+		t.Errorf("Expected the custom hasher to ignore case.")
+	}
+
+	checkPanic(t, `argument may not be nil {name: hash}`, func() {
+		NewHasher(nil, strings.EqualFold)
+	})
+	checkPanic(t, `argument may not be nil {name: equal}`, func() {
+		NewHasher(Hash[string], nil)
+	})
+}
@@ -0,0 +1,60 @@
+package comp
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/internal/liteUtils"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// Hasher gets hashes for values and determines if two values are equal.
+// This allows values which are not `comparable` to be used in
+// hash-based collections.
+//
+// Any two values which are equal must have the same hash.
+// Two values with the same hash may or may not be equal.
+type Hasher[T any] interface {
+	// Hash gets the hash for the given value.
+	Hash(value T) uint64
+
+	// Equal determines if the two given values are equal.
+	Equal(x, y T) bool
+}
+
+type hasherImp[T any] struct {
+	hash  func(value T) uint64
+	equal func(x, y T) bool
+}
+
+func (h hasherImp[T]) Hash(value T) uint64 {
+	return h.hash(value)
+}
+
+func (h hasherImp[T]) Equal(x, y T) bool {
+	return h.equal(x, y)
+}
+
+// NewHasher creates a hasher from the given hash and equal functions,
+// e.g. to compare strings case-insensitively
+// `comp.NewHasher(caseFoldHash, strings.EqualFold)`.
+//
+// The hash function must return the same hash for any two values
+// which the equal function determines are equal.
+// This will panic if either function is nil.
+func NewHasher[T any](hash func(value T) uint64, equal func(x, y T) bool) Hasher[T] {
+	if liteUtils.IsNil(hash) {
+		panic(terror.NilArg(`hash`))
+	}
+	if liteUtils.IsNil(equal) {
+		panic(terror.NilArg(`equal`))
+	}
+	return hasherImp[T]{hash: hash, equal: equal}
+}
+
+// DefaultHasher gets a hasher which uses `Hash` and `Equal`.
+//
+// Since `Hash` returns zero for values which are `Equatable` but not
+// `Hashable`, those values will all have the same hash. The values will
+// still be compared correctly but any hash-based collection of those
+// values will be slow. Implement `Hashable` or use `NewHasher` instead.
+func DefaultHasher[T any]() Hasher[T] {
+	return hasherImp[T]{hash: Hash[T], equal: Equal[T]}
+}
@@ -0,0 +1,167 @@
+package comp
+
+import (
+	"cmp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// Natural returns a comparer which compares strings in a natural order
+// where runs of digits are compared by their numeric value,
+// e.g. "file2" is less than "file10".
+//
+// Only the ASCII digits, 0 through 9, are treated as numbers and a run of
+// digits may be any length. Other runes are compared by their code point.
+// Bytes which aren't valid UTF-8 are compared by their byte value.
+// Numbers which are equal except for leading zeros are ordered by
+// the fewest leading zeros first, e.g. "a1" < "a01" < "a001",
+// but only when the rest of the strings are equal.
+func Natural() Comparer[string] {
+	return func(x, y string) int {
+		return compareStrings(x, y, true, ordinalWeight)
+	}
+}
+
+// CaseInsensitive returns a comparer which compares strings
+// by their code points after simple Unicode case folding,
+// e.g. "apple", "Apple", and "APPLE" are all equal.
+// Each rune is folded into a single rune, so runes which fold into
+// several runes aren't equal to them, e.g. "ß" isn't equal to "SS".
+//
+// Since strings which only differ by case are equal, to have a strict
+// order follow this with an ordinal comparer,
+// e.g. `comp.CaseInsensitive().ThenBy(comp.Ordered[string]())`.
+func CaseInsensitive() Comparer[string] {
+	return func(x, y string) int {
+		return compareStrings(x, y, false, foldWeight)
+	}
+}
+
+// NaturalCaseInsensitive returns a comparer which compares strings in a
+// natural order, the same as `Natural`, after simple Unicode case folding,
+// the same as `CaseInsensitive`, e.g. "File2" is less than "file10".
+func NaturalCaseInsensitive() Comparer[string] {
+	return func(x, y string) int {
+		return compareStrings(x, y, true, foldWeight)
+	}
+}
+
+// Collation returns a comparer which compares strings using the given
+// rule table. Each rule is a group of runes which are equal on the first
+// pass of the comparison, with the rules given in ascending order,
+// e.g. `comp.Collation("aA", "bB", "cC", ..., "nN", "ñÑ", "oO", ...)`.
+//
+// If two strings are equal on the first pass, the first rune which differs
+// by its position within the group decides the order. In the example above
+// "ab" < "Ab" < "ac". Any rune which isn't in a rule is ordered after all the
+// runes which are in a rule, by its code point.
+//
+// This will panic if a rune is in more than one rule.
+func Collation(rules ...string) Comparer[string] {
+	weights := map[rune]weight{}
+	for i, rule := range rules {
+		position := 0
+		for _, r := range rule {
+			if _, has := weights[r]; has {
+				panic(terror.New(`a rune may only be in one collation rule`).
+					With(`rune`, string(r)).
+					With(`rule`, rule))
+			}
+			weights[r] = weight{primary: i, secondary: position}
+			position++
+		}
+	}
+	unknown := len(rules)
+	return func(x, y string) int {
+		return compareStrings(x, y, false, func(r rune) weight {
+			if w, has := weights[r]; has {
+				return w
+			}
+			return weight{primary: unknown + int(r)}
+		})
+	}
+}
+
+// weight is the sort weight of a rune for comparing strings.
+// The primary weights are compared first, and only when all
+// the primary weights of two strings are equal,
+// the first difference in secondary weights is used.
+type weight struct {
+	primary   int
+	secondary int
+}
+
+// ordinalWeight gets the code point of the rune as the weight.
+func ordinalWeight(r rune) weight {
+	return weight{primary: int(r)}
+}
+
+// foldWeight gets the code point of the simple case folded rune as the weight.
+func foldWeight(r rune) weight {
+	return weight{primary: int(unicode.ToLower(unicode.ToUpper(r)))}
+}
+
+// isDigit determines if the given byte is an ASCII digit.
+func isDigit(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+// digitRun gets the run of digits at the start of the given string.
+func digitRun(s string) string {
+	i := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	return s[:i]
+}
+
+// compareStrings compares the two strings rune by rune using the given
+// weights for the runes. If natural is true, then runs of digits are
+// compared by their numeric value. Bytes which aren't valid UTF-8 are
+// compared by their byte value so that different invalid bytes aren't
+// all compared as the same replacement rune.
+func compareStrings(x, y string, natural bool, weigh func(r rune) weight) int {
+	tie := 0
+	for len(x) > 0 && len(y) > 0 {
+		if natural && isDigit(x[0]) && isDigit(y[0]) {
+			dx, dy := digitRun(x), digitRun(y)
+			tx, ty := strings.TrimLeft(dx, `0`), strings.TrimLeft(dy, `0`)
+			if c := cmp.Compare(len(tx), len(ty)); c != 0 {
+				return c
+			}
+			if c := strings.Compare(tx, ty); c != 0 {
+				return c
+			}
+			if tie == 0 {
+				tie = cmp.Compare(len(dx), len(dy))
+			}
+			x, y = x[len(dx):], y[len(dy):]
+			continue
+		}
+
+		rx, sx := utf8.DecodeRuneInString(x)
+		ry, sy := utf8.DecodeRuneInString(y)
+		if (rx == utf8.RuneError && sx == 1) || (ry == utf8.RuneError && sy == 1) {
+			if c := cmp.Compare(x[0], y[0]); c != 0 {
+				return c
+			}
+			x, y = x[1:], y[1:]
+			continue
+		}
+		wx, wy := weigh(rx), weigh(ry)
+		if c := cmp.Compare(wx.primary, wy.primary); c != 0 {
+			return c
+		}
+		if tie == 0 {
+			tie = cmp.Compare(wx.secondary, wy.secondary)
+		}
+		x, y = x[sx:], y[sy:]
+	}
+	if c := cmp.Compare(len(x), len(y)); c != 0 {
+		return c
+	}
+	return tie
+}
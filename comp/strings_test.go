@@ -0,0 +1,96 @@
+package comp
+
+import (
+	"strings"
+	"testing"
+)
+
+func checkStringOrder(t *testing.T, cmp Comparer[string], values ...string) {
+	t.Helper()
+	for i := 1; i < len(values); i++ {
+		x, y := values[i-1], values[i]
+		if c := cmp(x, y); c >= 0 {
+			t.Errorf("\nExpected %q to be less than %q but got %d.", x, y, c)
+		}
+		if c := cmp(y, x); c <= 0 {
+			t.Errorf("\nExpected %q to be greater than %q but got %d.", y, x, c)
+		}
+	}
+	for _, v := range values {
+		if c := cmp(v, v); c != 0 {
+			t.Errorf("\nExpected %q to equal itself but got %d.", v, c)
+		}
+	}
+}
+
+func Test_Comp_Natural(t *testing.T) {
+	c := Natural()
+	checkStringOrder(t, c, ``, `0`, `1`, `2`, `10`, `11`, `100`)
+	checkStringOrder(t, c, `file`, `file1`, `file2`, `file10`, `file10a`, `file10b`, `file11`, `fileA`)
+	checkStringOrder(t, c, `a1b2`, `a1b10`, `a2b1`, `a10b1`)
+	checkStringOrder(t, c, `1.2`, `1.10`, `1.10.1`, `2`)
+	checkStringOrder(t, c, `x9`, `x10`, `y`)
+	checkStringOrder(t, c, `File10`, `file2`)
+
+	// Leading zeros only matter when everything else is equal.
+	checkStringOrder(t, c, `a0`, `a00`, `a1`, `a01`, `a001`, `a2`)
+	checkStringOrder(t, c, `a01c`, `a1d`)
+	checkStringOrder(t, c, `a1b01`, `a01b1`)
+	checkComparer(t, c, `007`, `7`, 1)
+
+	// Digit runs longer than any integer type.
+	checkStringOrder(t, c,
+		`n`+strings.Repeat(`9`, 30),
+		`n1`+strings.Repeat(`0`, 30),
+		`n1`+strings.Repeat(`0`, 29)+`1`)
+
+	// Non-ASCII digits are compared by code point, not by value.
+	checkStringOrder(t, c, `x2`, `x10`, `x١٠`, `x٣`)
+
+	// Mixed scripts are compared by code point.
+	checkStringOrder(t, c, `Zebra`, `apple`, `ábaco`, `ключ2`, `ключ10`, `日本`)
+
+	// Invalid UTF-8 is compared by byte value, not as the replacement rune.
+	checkStringOrder(t, c, "a\xfe", "a\xff", "b")
+	checkStringOrder(t, c, "a\ufffd", "a\xff")
+	checkStringOrder(t, c, "a\xc3", "a\xc3\xa9")
+}
+
+func Test_Comp_CaseInsensitive(t *testing.T) {
+	c := CaseInsensitive()
+	checkComparer(t, c, `apple`, `APPLE`, 0)
+	checkComparer(t, c, `Apple`, `aPPle`, 0)
+	checkComparer(t, c, `ΣΊΣΥΦΟΣ`, `σίσυφος`, 0)
+	checkComparer(t, c, `straße`, `STRASSE`, 1)
+	checkComparer(t, c, `ſ`, `S`, 0)
+	checkComparer(t, c, `K`, `k`, 0) // Kelvin sign
+	checkComparer(t, c, "a\xfe", "A\xff", -1)
+	checkStringOrder(t, c, ``, `apple`, `Banana`, `cherry`, `Cherry pie`)
+	checkStringOrder(t, c, `file10`, `File2`)
+	checkStringOrder(t, c, `Zebra`, `ábaco`, `Ключ`, `日本`)
+
+	strict := c.ThenBy(Ordered[string]())
+	checkStringOrder(t, strict, `APPLE`, `Apple`, `apple`, `banana`)
+}
+
+func Test_Comp_NaturalCaseInsensitive(t *testing.T) {
+	c := NaturalCaseInsensitive()
+	checkComparer(t, c, `File10`, `file10`, 0)
+	checkStringOrder(t, c, `file1`, `FILE2`, `file3`, `File10`, `file20`)
+	checkStringOrder(t, c, `IMG_9.png`, `img_10.PNG`, `img_010.png`)
+	checkStringOrder(t, c, `Chapter 2`, `chapter 10`, `CHAPTER 10a`)
+}
+
+func Test_Comp_Collation(t *testing.T) {
+	c := Collation(`aAáÁ`, `bB`, `cC`, `nN`, `ñÑ`, `oO`)
+	checkStringOrder(t, c, `nana`, `niño`, `ñandú`, `oca`)
+	checkStringOrder(t, c, `ab`, `Ab`, `áb`, `ac`)
+	checkStringOrder(t, c, `a`, `aa`, `b`)
+	checkStringOrder(t, c, `cab`, `z`, `ő`)
+	checkStringOrder(t, c, ``, `A`, `z`)
+	checkComparer(t, c, `cab`, `cab`, 0)
+
+	checkPanic(t, `a rune may only be in one collation rule {rule: oa, rune: a}`, func() {
+		Collation(`ab`, `oa`)
+	})
+}
@@ -0,0 +1,251 @@
+package comp
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// Version is a semantic version, see https://semver.org/spec/v2.0.0.html.
+type Version struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+
+	// PreRelease is the dot separated identifiers after the hyphen,
+	// e.g. `1.0.0-alpha.1` has the pre-release identifiers `alpha` and `1`.
+	PreRelease []string
+
+	// Build is the dot separated identifiers after the plus,
+	// e.g. `1.0.0+build.5` has the build identifiers `build` and `5`.
+	Build []string
+}
+
+// ParseVersion parses a semantic version, e.g. `1.2.3`, `1.0.0-rc.1`,
+// or `1.0.0+20130313144700`. An optional leading `v` is allowed,
+// e.g. `v1.2.3`, since release tags are often written that way.
+//
+// The major, minor, and patch numbers are required and may not have
+// leading zeros. Identifiers may only contain ASCII alphanumerics and
+// hyphens, and numeric pre-release identifiers may not have leading zeros.
+// This returns an error if the given string isn't a valid semantic version.
+func ParseVersion(s string) (Version, error) {
+	text := strings.TrimPrefix(s, `v`)
+	v := Version{}
+	if core, build, has := strings.Cut(text, `+`); has {
+		parts, reason := parseIdentifiers(build, false)
+		if len(reason) > 0 {
+			return Version{}, invalidVersion(s, reason)
+		}
+		text, v.Build = core, parts
+	}
+	if core, pre, has := strings.Cut(text, `-`); has {
+		parts, reason := parseIdentifiers(pre, true)
+		if len(reason) > 0 {
+			return Version{}, invalidVersion(s, reason)
+		}
+		text, v.PreRelease = core, parts
+	}
+
+	numbers := strings.Split(text, `.`)
+	if len(numbers) != 3 {
+		return Version{}, invalidVersion(s, `must have major, minor, and patch numbers`)
+	}
+	targets := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, number := range numbers {
+		value, reason := parseNumber(number, false)
+		if len(reason) > 0 {
+			return Version{}, invalidVersion(s, reason)
+		}
+		*targets[i] = value
+	}
+	return v, nil
+}
+
+// invalidVersion creates the error for an invalid semantic version.
+func invalidVersion(version, reason string) error {
+	return terror.New(`invalid semantic version`).
+		With(`version`, version).
+		With(`reason`, reason)
+}
+
+// parseNumber parses a non-negative number. Returns the reason
+// the number is invalid, or an empty reason if it is valid.
+func parseNumber(number string, allowLeadingZeros bool) (uint64, string) {
+	switch {
+	case len(number) <= 0:
+		return 0, `a number may not be empty`
+	case strings.Trim(number, `0123456789`) != ``:
+		return 0, `a number must only contain digits`
+	case !allowLeadingZeros && len(number) > 1 && number[0] == '0':
+		return 0, `a number may not have leading zeros`
+	}
+	value, err := strconv.ParseUint(number, 10, 64)
+	if err != nil {
+		return 0, `a number is too large`
+	}
+	return value, ``
+}
+
+// parseIdentifiers splits and checks the pre-release or build identifiers.
+// If numeric is true then numeric identifiers may not have leading zeros.
+// Returns the reason the identifiers are invalid, or an empty reason if valid.
+func parseIdentifiers(text string, numeric bool) ([]string, string) {
+	parts := strings.Split(text, `.`)
+	for _, part := range parts {
+		if len(part) <= 0 {
+			return nil, `an identifier may not be empty`
+		}
+		for _, r := range part {
+			if !isIdentifierRune(r) {
+				return nil, `an identifier must only contain alphanumerics and hyphens`
+			}
+		}
+		if numeric && isDigits(part) && len(part) > 1 && part[0] == '0' {
+			return nil, `a numeric identifier may not have leading zeros`
+		}
+	}
+	return parts, ``
+}
+
+// isIdentifierRune determines if the given rune may be in an identifier.
+func isIdentifierRune(r rune) bool {
+	return r == '-' || ('0' <= r && r <= '9') || ('a' <= r && r <= 'z') || ('A' <= r && r <= 'Z')
+}
+
+// isDigits determines if the given string is only ASCII digits.
+func isDigits(s string) bool {
+	return len(s) > 0 && len(digitRun(s)) == len(s)
+}
+
+// String gets the semantic version as a string, without a leading `v`.
+func (v Version) String() string {
+	buf := &strings.Builder{}
+	buf.WriteString(strconv.FormatUint(v.Major, 10))
+	buf.WriteByte('.')
+	buf.WriteString(strconv.FormatUint(v.Minor, 10))
+	buf.WriteByte('.')
+	buf.WriteString(strconv.FormatUint(v.Patch, 10))
+	if len(v.PreRelease) > 0 {
+		buf.WriteByte('-')
+		buf.WriteString(strings.Join(v.PreRelease, `.`))
+	}
+	if len(v.Build) > 0 {
+		buf.WriteByte('+')
+		buf.WriteString(strings.Join(v.Build, `.`))
+	}
+	return buf.String()
+}
+
+// CompareTo compares this version to the other version by precedence.
+//
+// The major, minor, and patch numbers are compared numerically in that order.
+// A version with pre-release identifiers is less than the same version
+// without any. Pre-release identifiers are compared in order where numeric
+// identifiers are compared numerically and are less than other identifiers,
+// which are compared by ASCII order. If all the identifiers are equal,
+// the version with fewer identifiers is less. Build identifiers are ignored,
+// so versions which only differ by build have equal precedence.
+func (v Version) CompareTo(other Version) int {
+	if c := cmp.Compare(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	switch {
+	case len(v.PreRelease) <= 0 && len(other.PreRelease) <= 0:
+		return 0
+	case len(v.PreRelease) <= 0:
+		return 1
+	case len(other.PreRelease) <= 0:
+		return -1
+	}
+	return slices.CompareFunc(v.PreRelease, other.PreRelease, compareIdentifiers)
+}
+
+// compareIdentifiers compares two pre-release identifiers.
+func compareIdentifiers(x, y string) int {
+	nx, ny := isDigits(x), isDigits(y)
+	switch {
+	case nx && ny:
+		if c := cmp.Compare(len(x), len(y)); c != 0 {
+			return c
+		}
+		return strings.Compare(x, y)
+	case nx:
+		return -1
+	case ny:
+		return 1
+	default:
+		return strings.Compare(x, y)
+	}
+}
+
+// ParseDotted parses a dotted numeric identifier, e.g. `1.2.10` or `10.0`,
+// into its numbers. There may be any number of parts but each part must be
+// a non-negative number. An optional leading `v` is allowed, e.g. `v1.2`.
+// This returns an error if the given string isn't a valid dotted identifier.
+func ParseDotted(s string) ([]uint64, error) {
+	parts := strings.Split(strings.TrimPrefix(s, `v`), `.`)
+	numbers := make([]uint64, len(parts))
+	for i, part := range parts {
+		value, reason := parseNumber(part, true)
+		if len(reason) > 0 {
+			return nil, terror.New(`invalid dotted identifier`).
+				With(`identifier`, s).
+				With(`reason`, reason)
+		}
+		numbers[i] = value
+	}
+	return numbers, nil
+}
+
+// SemVer returns a comparer which compares strings as semantic versions
+// by precedence, see `ParseVersion` and `Version.CompareTo`,
+// e.g. "1.0.0-alpha" < "1.0.0-alpha.1" < "1.0.0-beta" < "1.0.0" < "1.0.1".
+//
+// Strings which aren't valid semantic versions are ordered after all
+// valid versions and are compared to each other by their code points.
+// Since build identifiers are ignored, to have a strict order follow
+// this with an ordinal comparer, e.g. `comp.SemVer().ThenBy(comp.Ordered[string]())`.
+func SemVer() Comparer[string] {
+	return parsedComparer(ParseVersion, Version.CompareTo)
+}
+
+// Dotted returns a comparer which compares dotted numeric identifiers
+// part by part numerically, see `ParseDotted`, e.g. "1.2.9" < "1.2.10".
+// When one identifier is a prefix of the other, the shorter identifier is
+// less, e.g. "1.2" < "1.2.0". Parts with leading zeros are equal to the
+// same number without them, e.g. "1.02" equals "1.2".
+//
+// Strings which aren't valid dotted identifiers are ordered after all
+// valid identifiers and are compared to each other by their code points.
+func Dotted() Comparer[string] {
+	return parsedComparer(ParseDotted, slices.Compare[[]uint64])
+}
+
+// parsedComparer creates a comparer which parses the strings and compares
+// the parsed values. Invalid strings are ordered after valid strings.
+func parsedComparer[T any](parse func(s string) (T, error), compare func(x, y T) int) Comparer[string] {
+	return func(x, y string) int {
+		vx, ex := parse(x)
+		vy, ey := parse(y)
+		switch {
+		case ex == nil && ey == nil:
+			return compare(vx, vy)
+		case ex == nil:
+			return -1
+		case ey == nil:
+			return 1
+		default:
+			return strings.Compare(x, y)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package comp
+
+import (
+	"slices"
+	"testing"
+)
+
+func Test_Comp_ParseVersion(t *testing.T) {
+	v, err := ParseVersion(`v1.2.3-rc.1+build.007`)
+	checkEqual(t, nil, err, true)
+	checkEqual(t, Version{
+		Major:      1,
+		Minor:      2,
+		Patch:      3,
+		PreRelease: []string{`rc`, `1`},
+		Build:      []string{`build`, `007`},
+	}, v, true)
+	if str := v.String(); str != `1.2.3-rc.1+build.007` {
+		t.Errorf("Unexpected version string: %s", str)
+	}
+	v2, _ := ParseVersion(`1.2.3`)
+	checkComparer(t, Default[Version](), v, v2, -1)
+
+	checkVersionError := func(s, exp string) {
+		t.Helper()
+		_, err := ParseVersion(s)
+		if err == nil || err.Error() != exp {
+			t.Errorf("\nUnexpected error from ParseVersion(%q):\n"+
+				"\tActual:   %v\n"+
+				"\tExpected: %s", s, err, exp)
+		}
+	}
+	checkVersionError(``, `invalid semantic version {reason: must have major, minor, and patch numbers, version: }`)
+	checkVersionError(`1.2`, `invalid semantic version {reason: must have major, minor, and patch numbers, version: 1.2}`)
+	checkVersionError(`1.2.x`, `invalid semantic version {reason: a number must only contain digits, version: 1.2.x}`)
+	checkVersionError(`1.02.3`, `invalid semantic version {reason: a number may not have leading zeros, version: 1.02.3}`)
+	checkVersionError(`1..3`, `invalid semantic version {reason: a number may not be empty, version: 1..3}`)
+	checkVersionError(`1.2.99999999999999999999`, `invalid semantic version {reason: a number is too large, version: 1.2.99999999999999999999}`)
+	checkVersionError(`1.2.3-`, `invalid semantic version {reason: an identifier may not be empty, version: 1.2.3-}`)
+	checkVersionError(`1.2.3-a..b`, `invalid semantic version {reason: an identifier may not be empty, version: 1.2.3-a..b}`)
+	checkVersionError(`1.2.3-01`, `invalid semantic version {reason: a numeric identifier may not have leading zeros, version: 1.2.3-01}`)
+	checkVersionError(`1.2.3+b_1`, `invalid semantic version {reason: an identifier must only contain alphanumerics and hyphens, version: 1.2.3+b_1}`)
+}
+
+func Test_Comp_SemVer(t *testing.T) {
+	// The example precedence from the SemVer 2.0 specification.
+	checkSort(t, SemVer(), []string{
+		`1.0.0`, `1.0.0-rc.1`, `1.0.0-beta.11`, `1.0.0-beta.2`, `1.0.0-beta`,
+		`1.0.0-alpha.beta`, `1.0.0-alpha.1`, `1.0.0-alpha`,
+	}, `1.0.0-alpha, 1.0.0-alpha.1, 1.0.0-alpha.beta, 1.0.0-beta, 1.0.0-beta.2, 1.0.0-beta.11, 1.0.0-rc.1, 1.0.0`)
+	checkSort(t, SemVer(), []string{`2.1.1`, `2.1.0`, `v2.0.0`, `1.10.0`, `1.9.0`},
+		`1.9.0, 1.10.0, v2.0.0, 2.1.0, 2.1.1`)
+
+	checkComparer(t, SemVer(), `1.0.0+a`, `1.0.0+b`, 0)
+	checkComparer(t, SemVer(), `1.0.0-a-1`, `1.0.0-a-2`, -1)
+	checkComparer(t, SemVer(), `1.0.0-2`, `1.0.0-10`, -1)
+	checkComparer(t, SemVer(), `1.0.0-999`, `1.0.0-a`, -1)
+	checkComparer(t, SemVer().ThenBy(Ordered[string]()), `1.0.0+a`, `1.0.0+b`, -1)
+
+	// Invalid versions are ordered after valid versions.
+	checkComparer(t, SemVer(), `9.9.9`, `1.0`, -1)
+	checkComparer(t, SemVer(), `1.0`, `9.9.9`, 1)
+	checkComparer(t, SemVer(), `b`, `a`, 1)
+}
+
+func Test_Comp_Dotted(t *testing.T) {
+	checkSort(t, Dotted(), []string{`1.2.10`, `1.2.9`, `1.2`, `1.10`, `1.2.0`, `x`, `10`},
+		`1.2, 1.2.0, 1.2.9, 1.2.10, 1.10, 10, x`)
+	checkComparer(t, Dotted(), `1.02`, `1.2`, 0)
+	checkComparer(t, Dotted(), `v3.1`, `3.1`, 0)
+
+	parts, err := ParseDotted(`10.0.3`)
+	checkEqual(t, nil, err, true)
+	if !slices.Equal(parts, []uint64{10, 0, 3}) {
+		t.Errorf("Unexpected dotted parts: %v", parts)
+	}
+	_, err = ParseDotted(`1.-2`)
+	if err == nil || err.Error() != `invalid dotted identifier {identifier: 1.-2, reason: a number must only contain digits}` {
+		t.Errorf("Unexpected error from ParseDotted: %v", err)
+	}
+}
@@ -0,0 +1,45 @@
+package examples
+
+import (
+	"os"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/records"
+)
+
+type scientist struct {
+	FirstName string `record:"First Name"`
+	LastName  string `record:"Last Name"`
+	BirthYear int    `record:"Birth Year"`
+	Focus     string
+}
+
+func getScientists() []scientist {
+	scientists, err := records.Unmarshal[scientist](
+		records.ReadMarkdown(strings.NewReader(mdFile))). // Reads the table in the file
+		ToSlice()
+	if err != nil {
+		panic(err)
+	}
+	return scientists
+}
+
+func Example_records_bornBefore1750() {
+	earliest := enumerator.Enumerate(getScientists()...).
+		Where(func(s scientist) bool { return s.BirthYear < 1750 }).
+		Sort(func(a, b scientist) int { return a.BirthYear - b.BirthYear })
+
+	err := records.WriteCSV(os.Stdout, records.Marshal(earliest))
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// First Name,Last Name,Birth Year,Focus
+	// Elena,Piscopia,1646,"Philosophy, Mathematics, Education"
+	// Maria,Merian,1647,"Biology, Entomology"
+	// Émilie,du Châtelet,1706,"Mathematics, Physics, Electronics"
+	// Laura,Bassi,1711,"Physics, Education"
+	// Maria,Agnesi,1718,"Mathematics, Calculus, Education"
+}
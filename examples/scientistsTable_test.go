@@ -0,0 +1,73 @@
+package examples
+
+import (
+	"fmt"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/table"
+)
+
+var (
+	firstName = table.Col[string](`First Name`)
+	lastName  = table.Col[string](`Last Name`)
+	birthYear = table.Col[int](`Birth Year`)
+	focus     = table.Col[collections.Set[string]](`Focus`)
+	century   = table.Compute(`Century`, func(row table.Row) int {
+		return birthYear.Get(row)/100 + 1
+	})
+)
+
+func getScientistTable() table.Table {
+	return table.FromSelect(getEntryEnumerator(), func(e entry) []any {
+		return []any{e.Value1(), e.Value2(), e.Value3(), e.Value4()}
+	}, firstName, lastName, birthYear, focus)
+}
+
+func hasFocus(name string) collections.Predicate[table.Row] {
+	return focus.Is(func(s collections.Set[string]) bool {
+		return s.Contains(name)
+	})
+}
+
+func Example_table_physicistsByYear() {
+	physicists := getScientistTable().
+		Where(hasFocus(`Physics`)).
+		Sort(birthYear.Ascending(), lastName.Ascending()).
+		Select(`Last Name`, `First Name`, `Birth Year`)
+
+	fmt.Println(physicists)
+
+	// Output:
+	// ┌─────────────┬──────────────┬────────────┐
+	// │ Last Name   │ First Name   │ Birth Year │
+	// ├─────────────┼──────────────┼────────────┤
+	// │ du Châtelet │ Émilie       │       1706 │
+	// │ Bassi       │ Laura        │       1711 │
+	// │ Germain     │ Sophie       │       1776 │
+	// │ Meitner     │ Lise         │       1878 │
+	// │ Wu          │ Chien-Shiung │       1912 │
+	// │ Ride        │ Sally        │       1951 │
+	// │ Profet      │ Margaret     │       1958 │
+	// └─────────────┴──────────────┴────────────┘
+}
+
+func Example_table_physiciansPerCentury() {
+	perCentury := getScientistTable().
+		Where(hasFocus(`Medicine`)).
+		Extend(century).
+		GroupBy([]string{`Century`},
+			table.Count(`Physicians`),
+			table.Min(`Earliest`, birthYear),
+			table.Max(`Latest`, birthYear)).
+		SortBy(`Century`)
+
+	fmt.Println(perCentury)
+
+	// Output:
+	// ┌─────────┬────────────┬──────────┬────────┐
+	// │ Century │ Physicians │ Earliest │ Latest │
+	// ├─────────┼────────────┼──────────┼────────┤
+	// │      19 │         10 │     1820 │   1898 │
+	// │      20 │          8 │     1902 │   1963 │
+	// └─────────┴────────────┴──────────┴────────┘
+}
@@ -0,0 +1,158 @@
+package equality
+
+import (
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/comp"
+)
+
+// Sequence determines if the two collections have equal values
+// in the same order. This is the equality for lists, queues, and stacks.
+func Sequence[T any](a, b collections.Collection[T]) bool {
+	return a.Count() == b.Count() &&
+		a.Enumerate().Equals(b.Enumerate())
+}
+
+// queueMarker is implemented by queues marked with `collections.QueueKind`.
+type queueMarker interface {
+	IsQueue() bool
+}
+
+// stackMarker is implemented by stacks marked with `collections.StackKind`.
+type stackMarker interface {
+	IsStack() bool
+}
+
+// Queue determines if the other value is a queue with equal values in the
+// same order. A stack marked with `collections.StackKind` is not a queue.
+func Queue[T any](q collections.ReadonlyQueue[T], other any) bool {
+	if m, ok := other.(stackMarker); ok && m.IsStack() {
+		return false
+	}
+	q2, ok := other.(collections.ReadonlyQueue[T])
+	return ok && Sequence[T](q, q2)
+}
+
+// Stack determines if the other value is a stack with equal values in the
+// same order. A queue marked with `collections.QueueKind` is not a stack.
+func Stack[T any](s collections.ReadonlyStack[T], other any) bool {
+	if m, ok := other.(queueMarker); ok && m.IsQueue() {
+		return false
+	}
+	s2, ok := other.(collections.ReadonlyStack[T])
+	return ok && Sequence[T](s, s2)
+}
+
+// SequenceHash gets the hash for a collection with sequence equality.
+func SequenceHash[T any](c collections.Collection[T]) uint64 {
+	hash := uint64(c.Count())
+	it := c.Enumerate().Iterate()
+	for it.Next() {
+		hash = comp.HashCombine(hash, comp.Hash(it.Current()))
+	}
+	return hash
+}
+
+// Custom is implemented by sets and dictionaries which may compare their
+// values or keys with a custom hasher or comparer. A collection using a custom
+// hasher or comparer is never equal to one which doesn't, since the two may
+// not agree on which values are equal.
+type Custom interface {
+	CustomEquality() bool
+}
+
+// IsCustom determines if the given collection compares its values
+// or keys with a custom hasher or comparer.
+func IsCustom(c any) bool {
+	cu, ok := c.(Custom)
+	return ok && cu.CustomEquality()
+}
+
+// Set determines if the two sets have the same members in any order.
+//
+// When both sets use a custom hasher or comparer, which may differ, each set
+// must contain the other's members and the sets must have the same hash.
+func Set[T any](a, b collections.ReadonlySet[T]) bool {
+	custom := IsCustom(a)
+	if a.Count() != b.Count() || custom != IsCustom(b) {
+		return false
+	}
+	return containsAll(a, b) &&
+		(!custom || (containsAll(b, a) && a.Hash() == b.Hash()))
+}
+
+// containsAll determines if the first set contains all the members of the second.
+func containsAll[T any](a, b collections.ReadonlySet[T]) bool {
+	it := b.Enumerate().Iterate()
+	for it.Next() {
+		if !a.Contains(it.Current()) {
+			return false
+		}
+	}
+	return true
+}
+
+// SetHash gets the hash for a collection with membership equality.
+// The hash of each member is summed so the order doesn't matter.
+func SetHash[T any](c collections.Collection[T]) uint64 {
+	return SetHashBy(c, comp.Hash[T])
+}
+
+// SetHashBy gets the hash for a collection with membership equality
+// using the given hash function for the members. This is the same as
+// `SetHash` when the given hash function is `comp.Hash`.
+func SetHashBy[T any](c collections.Collection[T], hash func(value T) uint64) uint64 {
+	sum := uint64(c.Count())
+	it := c.Enumerate().Iterate()
+	for it.Next() {
+		sum += comp.HashCombine(0, hash(it.Current()))
+	}
+	return sum
+}
+
+// Dictionary determines if the two dictionaries have the same keys
+// and the values for each key are equal.
+//
+// When both dictionaries use a custom hasher or comparer for the keys,
+// which may differ, each dictionary must have the other's keys and values
+// and the dictionaries must have the same hash.
+func Dictionary[TKey, TValue any](a, b collections.ReadonlyHashDictionary[TKey, TValue]) bool {
+	custom := IsCustom(a)
+	if a.Count() != b.Count() || custom != IsCustom(b) {
+		return false
+	}
+	return hasAll(a, b) &&
+		(!custom || (hasAll(b, a) && a.Hash() == b.Hash()))
+}
+
+// hasAll determines if the first dictionary has all the keys of the
+// second dictionary with equal values.
+func hasAll[TKey, TValue any](a, b collections.ReadonlyHashDictionary[TKey, TValue]) bool {
+	it := b.Enumerate().Iterate()
+	for it.Next() {
+		key, value := it.Current().Values()
+		v2, ok := a.TryGet(key)
+		if !ok || !comp.Equal(v2, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// DictionaryHash gets the hash for a dictionary with key/value equality.
+// The hash of each key and value pair is summed so the order doesn't matter.
+func DictionaryHash[TKey, TValue any](d collections.ReadonlyHashDictionary[TKey, TValue]) uint64 {
+	return DictionaryHashBy(d, comp.Hash[TKey])
+}
+
+// DictionaryHashBy gets the hash for a dictionary with key/value equality
+// using the given hash function for the keys. This is the same as
+// `DictionaryHash` when the given hash function is `comp.Hash`.
+func DictionaryHashBy[TKey, TValue any](d collections.ReadonlyHashDictionary[TKey, TValue], hash func(key TKey) uint64) uint64 {
+	sum := uint64(d.Count())
+	it := d.Enumerate().Iterate()
+	for it.Next() {
+		key, value := it.Current().Values()
+		sum += comp.HashCombine(hash(key), comp.Hash(value))
+	}
+	return sum
+}
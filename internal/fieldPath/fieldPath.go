@@ -0,0 +1,355 @@
+package fieldPath
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// step gets the next value along a path from the given value.
+// Returns false if the next value can not be reached,
+// such as when a pointer is nil or a map doesn't have a key.
+type step func(v reflect.Value) (reflect.Value, bool)
+
+// Path is a validated path to a value inside of another value,
+// e.g. `Address.City`, `Tags[0]`, or `Scores["math"]`.
+type Path[T, V any] struct {
+	steps []step
+	typ   reflect.Type
+}
+
+// New creates a path to a value of type V inside of a value of type T.
+//
+// The path is made of field names separated by dots, e.g. `Address.City`,
+// and indices or map keys in square brackets, e.g. `Tags[0]` or `Scores[math]`.
+// A map key may be quoted, e.g. `Scores["a.b"]`, to contain any characters.
+// Pointers along the path are followed.
+//
+// This will panic if the path is malformed or isn't valid for the types,
+// such as a field which doesn't exist or isn't exported, an index into
+// a value which isn't a slice, array, or map, a path through an interface,
+// or a value at the end of the path which can't be assigned to V.
+func New[T, V any](path string) Path[T, V] {
+	p, err := Parse[T, V](path, false)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Parse creates a path to a value of type V inside of a value of type T.
+// If ignoreCase is true then field names which don't match exactly
+// are matched to a field name without case. This is the same as `New`
+// except an error is returned instead of panicking.
+func Parse[T, V any](path string, ignoreCase bool) (Path[T, V], terrors.TError) {
+	parts, err := parse(path)
+	if err != nil {
+		return Path[T, V]{}, err
+	}
+
+	typ := utils.TypeOf[T]()
+	steps := make([]step, 0, len(parts)+1)
+	for _, p := range parts {
+		typ = deref(typ)
+		if typ.Kind() == reflect.Interface {
+			return Path[T, V]{}, invalid(`a path may not go through an interface`, path, typ, p)
+		}
+		var s step
+		if s, typ, err = p.resolve(path, typ, ignoreCase); err != nil {
+			return Path[T, V]{}, err
+		}
+		steps = append(steps, s)
+	}
+
+	target := utils.TypeOf[V]()
+	for !typ.AssignableTo(target) {
+		if typ.Kind() != reflect.Pointer {
+			return Path[T, V]{}, terror.New(`the value at the end of the path is not the expected type`).
+				With(`path`, path).
+				With(`type`, typ).
+				With(`expected`, target)
+		}
+		typ = typ.Elem()
+		steps = append(steps, elem)
+	}
+	return Path[T, V]{
+		steps: steps,
+		typ:   typ,
+	}, nil
+}
+
+// Type gets the type of the value at the end of the path
+// before it is assigned to V.
+func (p Path[T, V]) Type() reflect.Type {
+	return p.typ
+}
+
+// Get gets the value at the end of this path inside of the given value.
+// Returns the zero value and false if the value can not be reached,
+// such as when a pointer is nil or a map doesn't have a key.
+func (p Path[T, V]) Get(value T) (V, bool) {
+	v := reflect.ValueOf(&value).Elem()
+	for _, s := range p.steps {
+		var ok bool
+		if v, ok = s(v); !ok {
+			return utils.Zero[V](), false
+		}
+	}
+	var result V
+	reflect.ValueOf(&result).Elem().Set(v)
+	return result, true
+}
+
+// part is one field name, index, or map key in a path.
+type part struct {
+	name    string
+	indexed bool
+}
+
+func (p part) String() string {
+	if p.indexed {
+		return `[` + p.name + `]`
+	}
+	return p.name
+}
+
+// parse splits the given path into parts.
+func parse(path string) ([]part, terrors.TError) {
+	if len(path) <= 0 {
+		return nil, terror.New(`a field path may not be empty`)
+	}
+	malformed := func(pos int, reason string) terrors.TError {
+		return terror.New(`the field path is malformed`).
+			With(`path`, path).
+			With(`position`, pos).
+			With(`reason`, reason)
+	}
+
+	parts := []part{}
+	for pos := 0; pos < len(path); {
+		switch {
+		case path[pos] == '[':
+			end, key, err := parseKey(path, pos+1)
+			if err != nil {
+				return nil, malformed(pos, err.Error())
+			}
+			parts = append(parts, part{name: key, indexed: true})
+			pos = end
+
+		case pos > 0 && path[pos] != '.':
+			return nil, malformed(pos, `expected a dot or an opening bracket`)
+
+		default:
+			if path[pos] == '.' {
+				if pos == 0 {
+					return nil, malformed(pos, `expected a field name or an opening bracket`)
+				}
+				pos++
+			}
+			start := pos
+			for pos < len(path) && path[pos] != '.' && path[pos] != '[' {
+				pos++
+			}
+			name := path[start:pos]
+			if !isIdentifier(name) {
+				return nil, malformed(start, `expected a field name`)
+			}
+			parts = append(parts, part{name: name})
+		}
+	}
+	return parts, nil
+}
+
+// parseKey reads an index or map key starting just after an opening bracket.
+// Returns the position after the closing bracket and the key.
+func parseKey(path string, pos int) (int, string, error) {
+	if pos < len(path) && path[pos] == '"' {
+		end := pos + 1
+		for end < len(path) && path[end] != '"' {
+			if path[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(path) {
+			return 0, ``, terror.New(`expected a closing quote`)
+		}
+		key, err := strconv.Unquote(path[pos : end+1])
+		if err != nil {
+			return 0, ``, err
+		}
+		if end+1 >= len(path) || path[end+1] != ']' {
+			return 0, ``, terror.New(`expected a closing bracket`)
+		}
+		return end + 2, key, nil
+	}
+
+	end := strings.IndexByte(path[pos:], ']')
+	if end < 0 {
+		return 0, ``, terror.New(`expected a closing bracket`)
+	}
+	if end == 0 {
+		return 0, ``, terror.New(`expected an index or key`)
+	}
+	return pos + end + 1, path[pos : pos+end], nil
+}
+
+func isIdentifier(name string) bool {
+	if len(name) <= 0 {
+		return false
+	}
+	for i, r := range name {
+		if r != '_' && !('a' <= r && r <= 'z') && !('A' <= r && r <= 'Z') &&
+			(i == 0 || !('0' <= r && r <= '9')) {
+			return false
+		}
+	}
+	return true
+}
+
+func invalid(msg, path string, typ reflect.Type, p part) terrors.TError {
+	return terror.New(msg).
+		With(`path`, path).
+		With(`type`, typ).
+		With(`part`, p.String())
+}
+
+// deref gets the type pointed to by any number of pointers.
+func deref(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ
+}
+
+// follow follows the given value if it is a pointer.
+func follow(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, true
+}
+
+// elem follows the given pointer value one level.
+func elem(v reflect.Value) (reflect.Value, bool) {
+	if v.IsNil() {
+		return v, false
+	}
+	return v.Elem(), true
+}
+
+// resolve gets the step for this part of the path into the given type.
+// Returns the step and the type of the value the step gets.
+func (p part) resolve(path string, typ reflect.Type, ignoreCase bool) (step, reflect.Type, terrors.TError) {
+	if !p.indexed {
+		return p.resolveField(path, typ, ignoreCase)
+	}
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		return p.resolveIndex(path, typ)
+	case reflect.Map:
+		return p.resolveKey(path, typ)
+	default:
+		return nil, nil, invalid(`the value can not be indexed`, path, typ, p)
+	}
+}
+
+func (p part) resolveField(path string, typ reflect.Type, ignoreCase bool) (step, reflect.Type, terrors.TError) {
+	if typ.Kind() != reflect.Struct {
+		return nil, nil, invalid(`the value does not have fields`, path, typ, p)
+	}
+	field, ok := typ.FieldByName(p.name)
+	if !ok && ignoreCase {
+		field, ok = typ.FieldByNameFunc(func(name string) bool {
+			return strings.EqualFold(name, p.name)
+		})
+	}
+	if !ok {
+		return nil, nil, invalid(`no field with the given name exists`, path, typ, p)
+	}
+	if !field.IsExported() {
+		return nil, nil, invalid(`the field is not exported`, path, typ, p)
+	}
+	index := field.Index
+	return func(v reflect.Value) (reflect.Value, bool) {
+		v, ok := follow(v)
+		if !ok {
+			return v, false
+		}
+		v, err := v.FieldByIndexErr(index)
+		return v, err == nil
+	}, field.Type, nil
+}
+
+func (p part) resolveIndex(path string, typ reflect.Type) (step, reflect.Type, terrors.TError) {
+	index, err := strconv.Atoi(p.name)
+	if err != nil || index < 0 || (typ.Kind() == reflect.Array && index >= typ.Len()) {
+		return nil, nil, invalid(`the index is not valid for the value`, path, typ, p)
+	}
+	return func(v reflect.Value) (reflect.Value, bool) {
+		v, ok := follow(v)
+		if !ok || index >= v.Len() {
+			return v, false
+		}
+		return v.Index(index), true
+	}, typ.Elem(), nil
+}
+
+func (p part) resolveKey(path string, typ reflect.Type) (step, reflect.Type, terrors.TError) {
+	key, err := parseValue(p.name, typ.Key())
+	if err != nil {
+		return nil, nil, invalid(`the key is not valid for the map`, path, typ, p).
+			WithError(err)
+	}
+	return func(v reflect.Value) (reflect.Value, bool) {
+		v, ok := follow(v)
+		if !ok {
+			return v, false
+		}
+		v = v.MapIndex(key)
+		return v, v.IsValid()
+	}, typ.Elem(), nil
+}
+
+// parseValue parses the given text into a value of the given type for a map key.
+func parseValue(text string, typ reflect.Type) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+	switch typ.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return v, err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(text, typ.Bits())
+		if err != nil {
+			return v, err
+		}
+		v.SetFloat(f)
+	default:
+		return v, terror.New(`the map key type is not supported`)
+	}
+	return v, nil
+}
@@ -0,0 +1,147 @@
+package fieldPath
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+type (
+	address struct {
+		City string
+		Zip  *int
+	}
+
+	base struct {
+		ID int
+	}
+
+	person struct {
+		*base
+		Name    string
+		Home    *address
+		Tags    []string
+		Pair    [2]int
+		Scores  map[string]int
+		ByYear  map[int]*address
+		Extra   any
+		private int
+	}
+)
+
+func newPerson() person {
+	zip := 12345
+	return person{
+		base:   &base{ID: 7},
+		Name:   `Ada`,
+		Home:   &address{City: `London`, Zip: &zip},
+		Tags:   []string{`math`, `poetry`},
+		Pair:   [2]int{3, 4},
+		Scores: map[string]int{`math`: 98, `a.b`: 5},
+		ByYear: map[int]*address{1843: {City: `Paris`}},
+	}
+}
+
+func Test_FieldPath_Get(t *testing.T) {
+	p := newPerson()
+	checkGet(t, New[person, string](`Name`), p, `Ada`, true)
+	checkGet(t, New[person, string](`Home.City`), p, `London`, true)
+	checkGet(t, New[*person, string](`Home.City`), &p, `London`, true)
+	checkGet(t, New[person, int](`Home.Zip`), p, 12345, true)
+	checkGet(t, New[person, *int](`Home.Zip`), p, p.Home.Zip, true)
+	checkGet(t, New[person, string](`Tags[1]`), p, `poetry`, true)
+	checkGet(t, New[person, string](`Tags[2]`), p, ``, false)
+	checkGet(t, New[person, int](`Pair[1]`), p, 4, true)
+	checkGet(t, New[person, int](`Scores[math]`), p, 98, true)
+	checkGet(t, New[person, int](`Scores["a.b"]`), p, 5, true)
+	checkGet(t, New[person, int](`Scores[art]`), p, 0, false)
+	checkGet(t, New[person, string](`ByYear[1843].City`), p, `Paris`, true)
+	checkGet(t, New[person, string](`ByYear[1900].City`), p, ``, false)
+	checkGet(t, New[person, int](`ID`), p, 7, true)
+	checkGet(t, New[person, any](`Name`), p, any(`Ada`), true)
+	checkGet(t, New[person, any](`Extra`), p, nil, true)
+	checkGet(t, New[[]person, string](`[0].Name`), []person{p}, `Ada`, true)
+	checkGet(t, New[map[string]person, string](`[ada].Home.City`), map[string]person{`ada`: p}, `London`, true)
+
+	p.Home = nil
+	p.base = nil
+	checkGet(t, New[person, string](`Home.City`), p, ``, false)
+	checkGet(t, New[person, int](`ID`), p, 0, false)
+	checkGet(t, New[*person, string](`Name`), nil, ``, false)
+}
+
+func Test_FieldPath_Invalid(t *testing.T) {
+	checkPanic(t, `a field path may not be empty`, func() {
+		New[person, string](``)
+	})
+	checkPanic(t, `the field path is malformed {path: .Name, position: 0, reason: expected a field name or an opening bracket}`, func() {
+		New[person, string](`.Name`)
+	})
+	checkPanic(t, `the field path is malformed {path: Home., position: 5, reason: expected a field name}`, func() {
+		New[person, string](`Home.`)
+	})
+	checkPanic(t, `the field path is malformed {path: Tags[0, position: 4, reason: expected a closing bracket}`, func() {
+		New[person, string](`Tags[0`)
+	})
+	checkPanic(t, `the field path is malformed {path: Tags[], position: 4, reason: expected an index or key}`, func() {
+		New[person, string](`Tags[]`)
+	})
+	checkPanic(t, `the field path is malformed {path: Tags[0]x, position: 7, reason: expected a dot or an opening bracket}`, func() {
+		New[person, string](`Tags[0]x`)
+	})
+	checkPanic(t, `the field path is malformed {path: Scores["math], position: 6, reason: expected a closing quote}`, func() {
+		New[person, int](`Scores["math]`)
+	})
+	checkPanic(t, `no field with the given name exists {part: Age, path: Age, type: fieldPath.person}`, func() {
+		New[person, int](`Age`)
+	})
+	checkPanic(t, `the field is not exported {part: private, path: private, type: fieldPath.person}`, func() {
+		New[person, int](`private`)
+	})
+	checkPanic(t, `the value does not have fields {part: Length, path: Name.Length, type: string}`, func() {
+		New[person, int](`Name.Length`)
+	})
+	checkPanic(t, `the value can not be indexed {part: [0], path: Name[0], type: string}`, func() {
+		New[person, byte](`Name[0]`)
+	})
+	checkPanic(t, `the index is not valid for the value {part: [first], path: Tags[first], type: []string}`, func() {
+		New[person, string](`Tags[first]`)
+	})
+	checkPanic(t, `the index is not valid for the value {part: [2], path: Pair[2], type: [2]int}`, func() {
+		New[person, int](`Pair[2]`)
+	})
+	checkPanic(t, `the key is not valid for the map {part: [now], path: ByYear[now], type: map[int]*fieldPath.address}: `+
+		`strconv.ParseInt: parsing "now": invalid syntax: invalid syntax`, func() {
+		New[person, *address](`ByYear[now]`)
+	})
+	checkPanic(t, `a path may not go through an interface {part: Name, path: Extra.Name, type: interface {}}`, func() {
+		New[person, string](`Extra.Name`)
+	})
+	checkPanic(t, `the value at the end of the path is not the expected type {expected: int, path: Name, type: string}`, func() {
+		New[person, int](`Name`)
+	})
+}
+
+func checkGet[T, V any](t *testing.T, p Path[T, V], value T, exp V, expOk bool) {
+	t.Helper()
+	actual, ok := p.Get(value)
+	if ok != expOk || !reflect.DeepEqual(actual, exp) {
+		t.Errorf("\nUnexpected value from path:\n"+
+			"\tActual:   %v (%t)\n"+
+			"\tExpected: %v (%t)", actual, ok, exp, expOk)
+	}
+}
+
+func checkPanic(t *testing.T, exp string, handle func()) {
+	t.Helper()
+	actual := func() (r string) {
+		defer func() { r = fmt.Sprint(recover()) }()
+		handle()
+		return ``
+	}()
+	if actual != exp {
+		t.Errorf("\nUnexpected panic:\n"+
+			"\tActual:   %s\n"+
+			"\tExpected: %s", actual, exp)
+	}
+}
@@ -0,0 +1,282 @@
+package openTable
+
+import "github.com/Snow-Gremlin/goToolbox/comp"
+
+// minSlots is the smallest number of slots in a table with any slots.
+// The number of slots is always zero or a power of two.
+const minSlots = 8
+
+type slot[TKey, TValue any] struct {
+	used  bool
+	hash  uint64
+	key   TKey
+	value TValue
+}
+
+// Table is an open-addressing hash table for keys which are not comparable.
+//
+// The keys are hashed and checked for equality with the given hasher.
+// Collisions are resolved with linear probing and removals shift the
+// following entries back so that no tombstones are left in the table.
+// The table grows to keep the load at or below three quarters.
+type Table[TKey, TValue any] struct {
+	slots  []slot[TKey, TValue]
+	count  int
+	hasher comp.Hasher[TKey]
+}
+
+// New creates a new table using the given hasher with enough space
+// to hold the given number of entries before growing.
+func New[TKey, TValue any](hasher comp.Hasher[TKey], capacity int) *Table[TKey, TValue] {
+	return &Table[TKey, TValue]{
+		slots:  make([]slot[TKey, TValue], slotsFor(capacity)),
+		count:  0,
+		hasher: hasher,
+	}
+}
+
+// slotsFor gets the number of slots needed to hold the given number
+// of entries while keeping the load at or below three quarters.
+func slotsFor(capacity int) int {
+	if capacity <= 0 {
+		return 0
+	}
+	size := minSlots
+	for size*3 < capacity*4 {
+		size <<= 1
+	}
+	return size
+}
+
+// mix spreads the bits of the hash from the hasher, the finalizer
+// from SplitMix64, so that hashes with poor low bits still probe well.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// Hasher gets the hasher used by this table.
+func (t *Table[TKey, TValue]) Hasher() comp.Hasher[TKey] {
+	return t.hasher
+}
+
+// Count gets the number of entries in the table.
+func (t *Table[TKey, TValue]) Count() int {
+	return t.count
+}
+
+// find gets the index of the slot with the given key if found,
+// otherwise the index of the empty slot where the key would be added.
+// Returns -1 if the table has no slots.
+func (t *Table[TKey, TValue]) find(key TKey, hash uint64) (int, bool) {
+	if len(t.slots) <= 0 {
+		return -1, false
+	}
+	mask := uint64(len(t.slots) - 1)
+	for i := hash & mask; ; i = (i + 1) & mask {
+		s := &t.slots[i]
+		if !s.used {
+			return int(i), false
+		}
+		if s.hash == hash && t.hasher.Equal(s.key, key) {
+			return int(i), true
+		}
+	}
+}
+
+// Get gets the value for the given key and true,
+// or zero and false if the key isn't in the table.
+func (t *Table[TKey, TValue]) Get(key TKey) (TValue, bool) {
+	if index, found := t.find(key, mix(t.hasher.Hash(key))); found {
+		return t.slots[index].value, true
+	}
+	var zero TValue
+	return zero, false
+}
+
+// Has determines if the given key is in the table.
+func (t *Table[TKey, TValue]) Has(key TKey) bool {
+	_, found := t.find(key, mix(t.hasher.Hash(key)))
+	return found
+}
+
+// Set sets the value for the given key, overwriting any existing value.
+// Returns the previous value and true if the key existed,
+// otherwise zero and false if the key was added.
+func (t *Table[TKey, TValue]) Set(key TKey, value TValue) (TValue, bool) {
+	hash := mix(t.hasher.Hash(key))
+	index, found := t.find(key, hash)
+	if found {
+		prev := t.slots[index].value
+		t.slots[index].value = value
+		return prev, true
+	}
+	t.insert(index, hash, key, value)
+	var zero TValue
+	return zero, false
+}
+
+// Add adds the given key and value only if the key isn't in the table.
+// Returns true if the key was added, false if it already existed.
+func (t *Table[TKey, TValue]) Add(key TKey, value TValue) bool {
+	hash := mix(t.hasher.Hash(key))
+	index, found := t.find(key, hash)
+	if found {
+		return false
+	}
+	t.insert(index, hash, key, value)
+	return true
+}
+
+// insert puts a new entry into the empty slot at the given index,
+// growing the table first if needed.
+func (t *Table[TKey, TValue]) insert(index int, hash uint64, key TKey, value TValue) {
+	if (t.count+1)*4 > len(t.slots)*3 {
+		t.resize(slotsFor(t.count + 1))
+		index, _ = t.find(key, hash)
+	}
+	t.slots[index] = slot[TKey, TValue]{
+		used:  true,
+		hash:  hash,
+		key:   key,
+		value: value,
+	}
+	t.count++
+}
+
+// resize moves all the entries into a new set of slots.
+// The stored hashes are used so the keys are not rehashed.
+func (t *Table[TKey, TValue]) resize(size int) {
+	old := t.slots
+	t.slots = make([]slot[TKey, TValue], size)
+	mask := uint64(size - 1)
+	for _, s := range old {
+		if s.used {
+			i := s.hash & mask
+			for t.slots[i].used {
+				i = (i + 1) & mask
+			}
+			t.slots[i] = s
+		}
+	}
+}
+
+// Remove removes the given key from the table.
+// Returns true if the key was removed, false if it didn't exist.
+func (t *Table[TKey, TValue]) Remove(key TKey) bool {
+	index, found := t.find(key, mix(t.hasher.Hash(key)))
+	if !found {
+		return false
+	}
+	t.removeAt(index)
+	return true
+}
+
+// removeAt removes the entry at the given slot index and shifts any
+// following entries back which would no longer be reachable.
+func (t *Table[TKey, TValue]) removeAt(index int) {
+	mask := uint64(len(t.slots) - 1)
+	i := uint64(index)
+	for j := (i + 1) & mask; t.slots[j].used; j = (j + 1) & mask {
+		// Skip the entry if its ideal slot is cyclically within (i, j]
+		// since it can still be reached without passing the hole.
+		k := t.slots[j].hash & mask
+		if i <= j {
+			if i < k && k <= j {
+				continue
+			}
+		} else if i < k || k <= j {
+			continue
+		}
+		t.slots[i] = t.slots[j]
+		i = j
+	}
+	t.slots[i] = slot[TKey, TValue]{}
+	t.count--
+}
+
+// RemoveIf removes all the entries which the given predicate returns true for.
+// Returns true if any entry was removed.
+func (t *Table[TKey, TValue]) RemoveIf(predicate func(key TKey, value TValue) bool) bool {
+	keys := []TKey{}
+	t.Range(func(key TKey, value TValue) bool {
+		if predicate(key, value) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	for _, key := range keys {
+		t.Remove(key)
+	}
+	return len(keys) > 0
+}
+
+// Range calls the given handle for each entry in the table until
+// the handle returns false. The table must not be modified while ranging.
+// Returns false if the handle stopped the range.
+func (t *Table[TKey, TValue]) Range(handle func(key TKey, value TValue) bool) bool {
+	for i := range t.slots {
+		if s := &t.slots[i]; s.used && !handle(s.key, s.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys gets a slice of all the keys in the table.
+func (t *Table[TKey, TValue]) Keys() []TKey {
+	keys := make([]TKey, 0, t.count)
+	t.Range(func(key TKey, _ TValue) bool {
+		keys = append(keys, key)
+		return true
+	})
+	return keys
+}
+
+// Values gets a slice of all the values in the table.
+func (t *Table[TKey, TValue]) Values() []TValue {
+	values := make([]TValue, 0, t.count)
+	t.Range(func(_ TKey, value TValue) bool {
+		values = append(values, value)
+		return true
+	})
+	return values
+}
+
+// Clear removes all the entries from the table.
+func (t *Table[TKey, TValue]) Clear() {
+	t.slots = nil
+	t.count = 0
+}
+
+// Clone creates a copy of this table.
+func (t *Table[TKey, TValue]) Clone() *Table[TKey, TValue] {
+	slots := make([]slot[TKey, TValue], len(t.slots))
+	copy(slots, t.slots)
+	return &Table[TKey, TValue]{
+		slots:  slots,
+		count:  t.count,
+		hasher: t.hasher,
+	}
+}
+
+// Rehash recomputes the hash of every key and rebuilds the table.
+// This is needed if the keys were modified in a way that changes their
+// hash or equality. When keys have become equal, only the first one found
+// is kept. Returns true if any entries were dropped as duplicates.
+func (t *Table[TKey, TValue]) Rehash() bool {
+	old := t.slots
+	t.slots = make([]slot[TKey, TValue], len(old))
+	count := t.count
+	t.count = 0
+	for _, s := range old {
+		if s.used {
+			t.Add(s.key, s.value)
+		}
+	}
+	return t.count != count
+}
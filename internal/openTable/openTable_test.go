@@ -0,0 +1,114 @@
+package openTable
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/Snow-Gremlin/goToolbox/comp"
+)
+
+func Test_OpenTable(t *testing.T) {
+	// Hash by length so that slices with the same length collide.
+	h := comp.NewHasher(func(value []int) uint64 {
+		return uint64(len(value))
+	}, slices.Equal[[]int])
+	m := New[[]int, string](h, 0)
+	checkEqual(t, 0, m.Count(), `Count after New`)
+	checkEqual(t, false, m.Has([]int{1, 2}), `Has([1, 2]) before set`)
+	checkEqual(t, false, m.Remove([]int{1, 2}), `Remove([1, 2]) before set`)
+
+	checkEqual(t, true, m.Add([]int{1, 2}, `a`), `Add([1, 2]) when not set`)
+	checkEqual(t, false, m.Add([]int{1, 2}, `b`), `Add([1, 2]) when set`)
+	checkEqual(t, true, m.Add([]int{2, 1}, `c`), `Add([2, 1]) with colliding hash`)
+	checkEqual(t, 2, m.Count(), `Count after Add`)
+
+	prev, existed := m.Set([]int{1, 2}, `d`)
+	checkEqual(t, `a`, prev, `previous value from Set([1, 2])`)
+	checkEqual(t, true, existed, `existed from Set([1, 2])`)
+	prev, existed = m.Set([]int{3}, `e`)
+	checkEqual(t, ``, prev, `previous value from Set([3])`)
+	checkEqual(t, false, existed, `existed from Set([3])`)
+
+	value, found := m.Get([]int{1, 2})
+	checkEqual(t, `d`, value, `Get([1, 2])`)
+	checkEqual(t, true, found, `Get([1, 2]) found`)
+	_, found = m.Get([]int{1, 3})
+	checkEqual(t, false, found, `Get([1, 3]) found`)
+
+	c := m.Clone()
+	checkEqual(t, true, m.Remove([]int{1, 2}), `Remove([1, 2]) when set`)
+	checkEqual(t, true, m.Has([]int{2, 1}), `Has([2, 1]) after colliding key removed`)
+	checkEqual(t, 2, m.Count(), `Count after Remove`)
+	checkEqual(t, 3, c.Count(), `Count of clone after Remove`)
+	checkEqual(t, true, c.Has([]int{1, 2}), `Has([1, 2]) in clone`)
+
+	checkEqual(t, true, c.RemoveIf(func(key []int, _ string) bool {
+		return len(key) == 2
+	}), `RemoveIf with length of 2`)
+	checkEqual(t, `[[3]]`, fmt.Sprint(c.Keys()), `Keys after RemoveIf`)
+	checkEqual(t, `[e]`, fmt.Sprint(c.Values()), `Values after RemoveIf`)
+
+	m.Clear()
+	checkEqual(t, 0, m.Count(), `Count after Clear`)
+	checkEqual(t, false, m.Has([]int{3}), `Has([3]) after Clear`)
+	checkEqual(t, true, m.Add([]int{3}, `f`), `Add([3]) after Clear`)
+}
+
+func Test_OpenTable_Rehash(t *testing.T) {
+	m := New[[]int, int](comp.DefaultHasher[[]int](), 4)
+	a, b := []int{1}, []int{2}
+	m.Add(a, 1)
+	m.Add(b, 2)
+	checkEqual(t, false, m.Rehash(), `Rehash with no changes`)
+
+	// Modify a key so that it equals the other key.
+	b[0] = 1
+	checkEqual(t, true, m.Rehash(), `Rehash with duplicate keys`)
+	checkEqual(t, 1, m.Count(), `Count after Rehash`)
+	checkEqual(t, true, m.Has([]int{1}), `Has([1]) after Rehash`)
+}
+
+func Test_OpenTable_MatchesMap(t *testing.T) {
+	// Use a poor hash so that there are many collisions and long probes.
+	h := comp.NewHasher(func(value int) uint64 {
+		return uint64(value % 7)
+	}, func(x, y int) bool { return x == y })
+	m := New[int, int](h, 0)
+	exp := map[int]int{}
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 5000; i++ {
+		key := r.Intn(300)
+		switch r.Intn(3) {
+		case 0, 1:
+			_, existed := m.Set(key, i)
+			_, expExisted := exp[key]
+			exp[key] = i
+			checkEqual(t, expExisted, existed, `Set(`+strconv.Itoa(key)+`)`)
+		default:
+			_, expExisted := exp[key]
+			delete(exp, key)
+			checkEqual(t, expExisted, m.Remove(key), `Remove(`+strconv.Itoa(key)+`)`)
+		}
+		if m.Count() != len(exp) {
+			t.Fatalf("Expected count %d but got %d at step %d.", len(exp), m.Count(), i)
+		}
+	}
+	for key := 0; key < 300; key++ {
+		value, found := m.Get(key)
+		expValue, expFound := exp[key]
+		checkEqual(t, expFound, found, `Get(`+strconv.Itoa(key)+`) found`)
+		checkEqual(t, expValue, value, `Get(`+strconv.Itoa(key)+`)`)
+	}
+}
+
+func checkEqual(t *testing.T, exp, actual any, msg string) {
+	if actual != exp {
+		t.Errorf("\nUnexpected result in OpenTable:\n"+
+			"\tMessage:  %s\n"+
+			"\tExpected: %v\n"+
+			"\tActual:   %v\n", msg, exp, actual)
+	}
+}
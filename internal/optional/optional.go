@@ -1,6 +1,9 @@
 package optional
 
 import (
+	"bufio"
+	"context"
+
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/utils"
@@ -68,6 +71,25 @@ func After(size []int) int {
 	return max(-1, oneArg(size, -1, `after index`))
 }
 
+// TokenSize deals with an optional maximum token size.
+//
+// This may have zero or one values.
+// If there is no value or the value is zero or less,
+// the default `bufio.MaxScanTokenSize` is returned.
+// This will panic if more than one value.
+func TokenSize(size []int) int {
+	if maxSize := oneArg(size, 0, `max token size`); maxSize > 0 {
+		return maxSize
+	}
+	return bufio.MaxScanTokenSize
+}
+
+// Given determines if an optional value was given which isn't nil,
+// e.g. to know if a custom comparer or hasher is being used.
+func Given[T any](values []T) bool {
+	return len(values) > 0 && !utils.IsNil(values[0])
+}
+
 // Comparer deals with an optional comparer.
 //
 // This may have zero or one comparer.
@@ -92,3 +114,37 @@ func Comparer[T any](comps []comp.Comparer[T]) comp.Comparer[T] {
 	panic(terror.New(`must provide a comparer to compare this type`).
 		With(`type`, utils.TypeOf[T]()))
 }
+
+// Hasher deals with an optional hasher.
+//
+// This may have zero or one hasher.
+// If there is no hasher or a nil hasher was given, the default hasher is returned.
+// This will panic if more than one hasher is given.
+func Hasher[T any](hashers []comp.Hasher[T]) comp.Hasher[T] {
+	if count := len(hashers); count > 0 {
+		if count > 1 {
+			panic(terror.InvalidArgCount(1, count, `hasher`))
+		}
+		if h := hashers[0]; !utils.IsNil(h) {
+			return h
+		}
+	}
+	return comp.DefaultHasher[T]()
+}
+
+// Context deals with an optional context.
+//
+// This may have zero or one context.
+// If there is no context or a nil context was given, the background context is returned.
+// This will panic if more than one context is given.
+func Context(ctxs []context.Context) context.Context {
+	if count := len(ctxs); count > 0 {
+		if count > 1 {
+			panic(terror.InvalidArgCount(1, count, `context`))
+		}
+		if ctx := ctxs[0]; !utils.IsNil(ctx) {
+			return ctx
+		}
+	}
+	return context.Background()
+}
@@ -1,6 +1,8 @@
 package optional
 
 import (
+	"bufio"
+	"context"
 	"strings"
 	"testing"
 
@@ -94,6 +96,15 @@ func Test_Optional_After(t *testing.T) {
 		func() { After([]int{1, 2}) })
 }
 
+func Test_Optional_TokenSize(t *testing.T) {
+	checkEqual(t, bufio.MaxScanTokenSize, TokenSize([]int{}))
+	checkEqual(t, bufio.MaxScanTokenSize, TokenSize([]int{0}))
+	checkEqual(t, bufio.MaxScanTokenSize, TokenSize([]int{-4}))
+	checkEqual(t, 12, TokenSize([]int{12}))
+	checkPanic(t, `invalid number of arguments {count: 2, maximum: 1, usage: max token size}`,
+		func() { TokenSize([]int{1, 2}) })
+}
+
 func Test_Optional_Comparer(t *testing.T) {
 	cmp1 := Comparer([]comp.Comparer[int]{})
 	checkEqual(t, -1, cmp1(1, 2))
@@ -117,6 +128,36 @@ func Test_Optional_Comparer(t *testing.T) {
 		func() { Comparer([]comp.Comparer[[]int]{}) })
 }
 
+func Test_Optional_Hasher(t *testing.T) {
+	h1 := Hasher([]comp.Hasher[[]int]{})
+	checkEqual(t, true, h1.Equal([]int{1, 2}, []int{1, 2}))
+	checkEqual(t, h1.Hash([]int{1, 2}), h1.Hash([]int{1, 2}))
+	h1 = Hasher([]comp.Hasher[[]int]{nil})
+	checkEqual(t, false, h1.Equal([]int{1, 2}, []int{2, 1}))
+	h2 := comp.NewHasher(func(value string) uint64 {
+		return uint64(len(value))
+	}, strings.EqualFold)
+	checkEqual(t, true, Hasher([]comp.Hasher[string]{h2}).Equal(`Cat`, `cAT`))
+	checkPanic(t, `invalid number of arguments {count: 2, maximum: 1, usage: hasher}`,
+		func() { Hasher([]comp.Hasher[string]{nil, nil}) })
+}
+
+func Test_Optional_Given(t *testing.T) {
+	checkEqual(t, false, Given([]comp.Comparer[int]{}))
+	checkEqual(t, false, Given([]comp.Comparer[int]{nil}))
+	checkEqual(t, true, Given([]comp.Comparer[int]{comp.Ordered[int]()}))
+}
+
+func Test_Optional_Context(t *testing.T) {
+	checkEqual(t, context.Background(), Context([]context.Context{}))
+	checkEqual(t, context.Background(), Context([]context.Context{nil}))
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	checkEqual(t, ctx, Context([]context.Context{ctx}))
+	checkPanic(t, `invalid number of arguments {count: 2, maximum: 1, usage: context}`,
+		func() { Context([]context.Context{ctx, ctx}) })
+}
+
 func checkEqual(t *testing.T, expected, actual any) {
 	if !comp.Equal(expected, actual) {
 		t.Errorf("\n"+
@@ -0,0 +1,207 @@
+package readers
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unsafe"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// initialBufferSize is the starting size of the buffer used to read
+// tokens. The buffer will grow up to the maximum token size.
+const initialBufferSize = 4096
+
+// Fetcher gets the next value read from a reader.
+// Returns false once there are no more values.
+// Returns an error if reading failed, after which it should not be called.
+type Fetcher[T any] func() (T, bool, error)
+
+// Source starts a new iteration of the values read from a reader.
+type Source[T any] func() Fetcher[T]
+
+// Split creates a source for the strings from splitting the data read from
+// the given reader with the given separator. The strings are split the same
+// as `enumerator.SplitFunc` would split the whole data read into a string.
+// A string longer than the given maximum size fails to be read.
+//
+// The strings given to the separator share memory with the read buffer,
+// so the separator must not keep the strings after it returns.
+func Split(r io.Reader, separator utils.StringMatcher, maxSize int) Source[string] {
+	return newSource(r,
+		func(r io.Reader) *tokenScanner {
+			return newTokenScanner(r, separator, maxSize)
+		},
+		(*tokenScanner).next)
+}
+
+// Runes creates a source for the runes decoded from
+// the UTF-8 data read from the given reader.
+func Runes(r io.Reader) Source[rune] {
+	return newSource(r, newRuneReader, (*runeReader).next)
+}
+
+// LineSeparator is a string matcher for the separators used for lines,
+// `\n\r`, `\n`, `\r`, `\r\n`, or `\u2029` (paragraph separator, UTF-8 is \xE2\x80\xA9).
+func LineSeparator(part string) (int, int) {
+	for i, count := 0, len(part); i < count; i++ {
+		c := part[i]
+		switch c {
+		case '\r':
+			if i+1 < count && part[i+1] == '\n' {
+				return i, 2
+			}
+			return i, 1
+		case '\n':
+			if i+1 < count && part[i+1] == '\r' {
+				return i, 2
+			}
+			return i, 1
+		case '\xE2':
+			if i+2 < count && part[i+1] == '\x80' && part[i+2] == '\xA9' {
+				return i, 3
+			}
+		}
+	}
+	return -1, 0
+}
+
+// newSource creates a source for values read from the given reader.
+//
+// If the reader is a seeker, the first iteration records the current position
+// and each following iteration seeks back to that position and starts a new
+// reading state. Otherwise a single reading state is shared by all the
+// iterations so that any data buffered by a prior iteration isn't lost.
+// If getting the position or seeking fails, the iteration fails right away.
+func newSource[T, S any](r io.Reader, newState func(r io.Reader) S, next func(S) (T, bool, error)) Source[T] {
+	iterate := func(state S) Fetcher[T] {
+		return func() (T, bool, error) {
+			return next(state)
+		}
+	}
+	fail := func(err error) Fetcher[T] {
+		return func() (T, bool, error) {
+			return utils.Zero[T](), false, err
+		}
+	}
+
+	seeker, ok := r.(io.Seeker)
+	if !ok {
+		var state S
+		started := false
+		return func() Fetcher[T] {
+			if !started {
+				state, started = newState(r), true
+			}
+			return iterate(state)
+		}
+	}
+
+	started := false
+	var start int64
+	return func() Fetcher[T] {
+		if !started {
+			offset, err := seeker.Seek(0, io.SeekCurrent)
+			if err != nil {
+				return fail(terror.New(`failed to get the reader position`, err))
+			}
+			start, started = offset, true
+		} else if _, err := seeker.Seek(start, io.SeekStart); err != nil {
+			return fail(terror.New(`failed to seek the reader`, err).
+				With(`offset`, start))
+		}
+		return iterate(newState(r))
+	}
+}
+
+// tokenScanner reads and splits the data from a reader using a separator.
+type tokenScanner struct {
+	scanner *bufio.Scanner
+	maxSize int
+	done    bool
+
+	// trailing is true when the last token ended with a separator,
+	// meaning an empty token is still needed at the end of the data.
+	trailing bool
+}
+
+func newTokenScanner(r io.Reader, separator utils.StringMatcher, maxSize int) *tokenScanner {
+	s := &tokenScanner{
+		scanner:  bufio.NewScanner(r),
+		maxSize:  maxSize,
+		done:     false,
+		trailing: true,
+	}
+	s.scanner.Buffer(make([]byte, 0, min(initialBufferSize, maxSize)), maxSize)
+	s.scanner.Split(func(data []byte, atEOF bool) (int, []byte, error) {
+		if len(data) > 0 {
+			// The data isn't copied since this is called for every token
+			// and the data may be large. The separator must not keep the
+			// string since the buffer is overwritten by following reads.
+			start, length := separator(unsafe.String(&data[0], len(data)))
+			if start >= 0 {
+				end := start + length
+				// When the separator reaches the end of the data, more data
+				// is needed to be sure the separator isn't part of a longer one.
+				if end < len(data) || atEOF {
+					s.trailing = true
+					return end, data[:start], nil
+				}
+			}
+		}
+		if !atEOF || len(data) == 0 {
+			return 0, nil, nil
+		}
+		s.trailing = false
+		return len(data), data, nil
+	})
+	return s
+}
+
+// next gets the next token. A trailing separator or empty data results in
+// a final empty string, matching the behavior of `enumerator.SplitFunc`.
+func (s *tokenScanner) next() (string, bool, error) {
+	if s.done {
+		return utils.Zero[string](), false, nil
+	}
+	if s.scanner.Scan() {
+		return s.scanner.Text(), true, nil
+	}
+	s.done = true
+	if err := s.scanner.Err(); err != nil {
+		if errors.Is(err, bufio.ErrTooLong) {
+			err = terror.New(`token is longer than the maximum token size`, err).
+				With(`max token size`, s.maxSize)
+		}
+		return utils.Zero[string](), false, err
+	}
+	if s.trailing {
+		return ``, true, nil
+	}
+	return utils.Zero[string](), false, nil
+}
+
+// runeReader reads and decodes the runes from a reader.
+type runeReader struct {
+	reader *bufio.Reader
+}
+
+func newRuneReader(r io.Reader) *runeReader {
+	return &runeReader{
+		reader: bufio.NewReaderSize(r, initialBufferSize),
+	}
+}
+
+// next gets the next rune.
+func (r *runeReader) next() (rune, bool, error) {
+	value, _, err := r.reader.ReadRune()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return utils.Zero[rune](), false, nil
+		}
+		return utils.Zero[rune](), false, err
+	}
+	return value, true, nil
+}
@@ -0,0 +1,114 @@
+package records
+
+import (
+	"strings"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+)
+
+// rowCounter tracks the index of the row being read or written
+// and checks that each row has the same number of values as the header.
+type rowCounter struct {
+	row   int
+	width int
+}
+
+func newRowCounter() *rowCounter {
+	return &rowCounter{}
+}
+
+// check checks that the given values have the same number of values as the header.
+// The first values checked are the header. The line is the one based line number
+// the values were read from, or zero if the values were not read from a line.
+func (c *rowCounter) check(values []string, line int) error {
+	if c.row == 0 {
+		c.width = len(values)
+	} else if len(values) != c.width {
+		return mismatch(c.row, line, c.width, len(values))
+	}
+	c.row++
+	return nil
+}
+
+func mismatch(row, line, expected, count int) terrors.TError {
+	err := terror.New(`the number of values in the row doesn't match the header`).
+		With(`row`, row).
+		With(`expected`, expected).
+		With(`count`, count)
+	if line > 0 {
+		err.With(`line`, line)
+	}
+	return err
+}
+
+func isBlank(line string) bool {
+	return len(strings.TrimSpace(line)) <= 0
+}
+
+func hasPipe(line string) bool {
+	return strings.ContainsRune(line, '|')
+}
+
+// splitMarkdown splits a row of a Markdown pipe table into trimmed values.
+// The leading and trailing pipes are optional and escaped pipes are unescaped.
+func splitMarkdown(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, `|`)
+	if strings.HasSuffix(line, `|`) && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	values := []string{}
+	value := strings.Builder{}
+	escaped := false
+	for _, r := range line {
+		switch {
+		case escaped:
+			if r != '|' {
+				value.WriteRune('\\')
+			}
+			value.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '|':
+			values = append(values, strings.TrimSpace(value.String()))
+			value.Reset()
+		default:
+			value.WriteRune(r)
+		}
+	}
+	if escaped {
+		value.WriteRune('\\')
+	}
+	return append(values, strings.TrimSpace(value.String()))
+}
+
+// isDelimiterRow determines if the given line is the delimiter row
+// of a Markdown pipe table, the row of dashes after the header.
+func isDelimiterRow(line string) bool {
+	if !hasPipe(line) {
+		return false
+	}
+	for _, value := range splitMarkdown(line) {
+		value = strings.TrimPrefix(value, `:`)
+		value = strings.TrimSuffix(value, `:`)
+		if len(value) <= 0 || strings.Trim(value, `-`) != `` {
+			return false
+		}
+	}
+	return true
+}
+
+// escapeMarkdown escapes a value so that it can be written into
+// a Markdown pipe table without breaking the row.
+func escapeMarkdown(value string) string {
+	return markdownEscaper.Replace(value)
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`|`, `\|`,
+	"\r\n", `<br>`,
+	"\n", `<br>`,
+	"\r", `<br>`)
@@ -0,0 +1,196 @@
+package records
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+var (
+	durationType        = utils.TypeOf[time.Duration]()
+	textMarshalerType   = utils.TypeOf[encoding.TextMarshaler]()
+	textUnmarshalerType = utils.TypeOf[encoding.TextUnmarshaler]()
+)
+
+// field is a field of a struct which is read from and written to a column.
+type field struct {
+	name  string
+	field string
+	index int
+	typ   reflect.Type
+}
+
+// fields is the fields of a struct in the order of the fields.
+type fields []field
+
+// fieldsOf gets the fields of the given struct type.
+// This will panic if the type isn't a struct or has an unsupported field.
+func fieldsOf[T any]() fields {
+	typ := utils.TypeOf[T]()
+	if typ.Kind() != reflect.Struct {
+		panic(terror.New(`the record type must be a struct`).
+			With(`type`, typ))
+	}
+
+	result := fields{}
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		name, ok := sf.Tag.Lookup(TagName)
+		if name == `-` {
+			continue
+		}
+		if !ok || len(name) <= 0 {
+			name = sf.Name
+		}
+		if !supported(sf.Type) {
+			panic(terror.New(`the field type is not supported`).
+				With(`type`, typ).
+				With(`field`, sf.Name).
+				With(`field type`, sf.Type))
+		}
+		result = append(result, field{
+			name:  name,
+			field: sf.Name,
+			index: i,
+			typ:   sf.Type,
+		})
+	}
+	return result
+}
+
+// supported determines if values of the given type can be parsed and formatted.
+// A type which implements only one of the text marshaler and text unmarshaler
+// isn't supported since it would be read and written differently.
+func supported(typ reflect.Type) bool {
+	ptr := reflect.PointerTo(typ)
+	marshaler, unmarshaler := ptr.Implements(textMarshalerType), ptr.Implements(textUnmarshalerType)
+	if marshaler || unmarshaler {
+		return marshaler && unmarshaler
+	}
+	switch typ.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
+
+// columns gets the index of the column in the given header for each field.
+// Returns an error if the header is missing the column for a field or
+// has more than one column with the name of a field.
+func (fs fields) columns(header []string) ([]int, error) {
+	indices := make(map[string]int, len(header))
+	for i, name := range header {
+		if _, exists := indices[name]; exists {
+			indices[name] = -1
+			continue
+		}
+		indices[name] = i
+	}
+
+	columns := make([]int, len(fs))
+	for i, f := range fs {
+		index, ok := indices[f.name]
+		if !ok {
+			return nil, terror.New(`the header is missing the column for a field`).
+				With(`row`, 0).
+				With(`column`, f.name).
+				With(`field`, f.field)
+		}
+		if index < 0 {
+			return nil, terror.New(`the header has more than one column for a field`).
+				With(`row`, 0).
+				With(`column`, f.name).
+				With(`field`, f.field)
+		}
+		columns[i] = index
+	}
+	return columns, nil
+}
+
+// parse parses the given text into this field of the given struct value.
+func (f field) parse(value reflect.Value, text string) error {
+	target := value.Field(f.index)
+	if u, ok := target.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	if f.typ.Kind() == reflect.String {
+		target.SetString(text)
+		return nil
+	}
+	if len(text) <= 0 {
+		return nil
+	}
+
+	if f.typ == durationType {
+		d, err := time.ParseDuration(text)
+		if err == nil {
+			target.SetInt(int64(d))
+		}
+		return err
+	}
+
+	switch f.typ.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err == nil {
+			target.SetBool(b)
+		}
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(text, 10, f.typ.Bits())
+		if err == nil {
+			target.SetInt(i)
+		}
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(text, 10, f.typ.Bits())
+		if err == nil {
+			target.SetUint(u)
+		}
+		return err
+	default: // reflect.Float32, reflect.Float64
+		x, err := strconv.ParseFloat(text, f.typ.Bits())
+		if err == nil {
+			target.SetFloat(x)
+		}
+		return err
+	}
+}
+
+// format formats this field of the given addressable struct value into text.
+func (f field) format(value reflect.Value) (string, error) {
+	source := value.Field(f.index)
+	if m, ok := source.Addr().Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	if f.typ == durationType {
+		return time.Duration(source.Int()).String(), nil
+	}
+
+	switch f.typ.Kind() {
+	case reflect.String:
+		return source.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(source.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(source.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(source.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(source.Float(), 'g', -1, f.typ.Bits()), nil
+	default:
+		return source.String(), nil
+	}
+}
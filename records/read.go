@@ -0,0 +1,130 @@
+package records
+
+import (
+	"bufio"
+	"encoding/csv"
+	"errors"
+	"io"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/tryEnumerator"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// ReadCSV lazily reads the rows of comma-separated values from the given reader.
+//
+// The first row is the header and every row must have the same number of
+// values as the header. Quoted values may contain commas, quotes, and new lines.
+// The reader is read as the rows are enumerated, so the returned
+// enumerator can only be enumerated once.
+func ReadCSV(r io.Reader) collections.TryEnumerator[[]string] {
+	return readDelimited(r, ',')
+}
+
+// ReadTSV lazily reads the rows of tab-separated values from the given reader.
+//
+// The first row is the header and every row must have the same number of
+// values as the header. Quoted values may contain tabs, quotes, and new lines.
+// The reader is read as the rows are enumerated, so the returned
+// enumerator can only be enumerated once.
+func ReadTSV(r io.Reader) collections.TryEnumerator[[]string] {
+	return readDelimited(r, '\t')
+}
+
+// ReadMarkdown lazily reads the rows of the first pipe table from
+// the given Markdown reader.
+//
+// Any lines before the table, such as a title, are skipped. The table starts
+// with the header row followed by the delimiter row, e.g. `|:---|:---:|`,
+// and ends at the first blank line or the end of the reader.
+// The header is returned as the first row but the delimiter row is not returned.
+// The values are trimmed and any escaped pipes, `\|`, are unescaped.
+// Every row must have the same number of values as the header.
+// The reader is read as the rows are enumerated, so the returned
+// enumerator can only be enumerated once.
+func ReadMarkdown(r io.Reader) collections.TryEnumerator[[]string] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	return tryEnumerator.New(func() collections.TryIterator[[]string] {
+		scanner := bufio.NewScanner(r)
+		rows := newRowCounter()
+		line := 0
+		inTable := false
+		var header []string
+		return tryEnumerator.NewIterator(func() ([]string, bool, error) {
+			for scanner.Scan() {
+				line++
+				text := scanner.Text()
+				if inTable {
+					if isBlank(text) {
+						break
+					}
+					values := splitMarkdown(text)
+					if err := rows.check(values, line); err != nil {
+						return nil, false, err
+					}
+					return values, true, nil
+				}
+
+				if header == nil || !isDelimiterRow(text) {
+					header = nil
+					if hasPipe(text) {
+						header = splitMarkdown(text)
+					}
+					continue
+				}
+
+				if err := rows.check(header, line-1); err != nil {
+					return nil, false, err
+				}
+				if count := len(splitMarkdown(text)); count != len(header) {
+					return nil, false, mismatch(0, line, len(header), count)
+				}
+				inTable = true
+				return header, true, nil
+			}
+			if err := scanner.Err(); err != nil {
+				return nil, false, terror.New(`failed to read markdown`, err).
+					With(`line`, line+1)
+			}
+			return nil, false, nil
+		})
+	})
+}
+
+// readDelimited lazily reads the rows of values from the given reader
+// where the values are separated by the given separator.
+func readDelimited(r io.Reader, comma rune) collections.TryEnumerator[[]string] {
+	if utils.IsNil(r) {
+		panic(terror.NilArg(`r`))
+	}
+	return tryEnumerator.New(func() collections.TryIterator[[]string] {
+		reader := csv.NewReader(r)
+		reader.Comma = comma
+		reader.FieldsPerRecord = -1
+		rows := newRowCounter()
+		return tryEnumerator.NewIterator(func() ([]string, bool, error) {
+			values, err := reader.Read()
+			if errors.Is(err, io.EOF) {
+				return nil, false, nil
+			}
+			if err != nil {
+				e := terror.New(`failed to read row`, err).
+					With(`row`, rows.row)
+				var parseErr *csv.ParseError
+				if errors.As(err, &parseErr) {
+					e.With(`line`, parseErr.Line).
+						With(`position`, parseErr.Column)
+				}
+				return nil, false, e
+			}
+			line, _ := reader.FieldPos(0)
+			if err := rows.check(values, line); err != nil {
+				return nil, false, err
+			}
+			return values, true, nil
+		})
+	})
+}
@@ -0,0 +1,109 @@
+package records
+
+import (
+	"reflect"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/collections/iterator"
+	"github.com/Snow-Gremlin/goToolbox/collections/tryEnumerator"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// TagName is the name of the struct field tag used to
+// set the name of the column for a field, e.g. `record:"Birth Year"`.
+// Use `record:"-"` to skip a field. Any exported field without
+// a tag uses the name of the field as the name of the column.
+const TagName = `record`
+
+// Unmarshal reads each row after the header into a new struct.
+//
+// The first row is the header which is used to find the column for each
+// field of the struct. Columns without a field are ignored. The fields may be
+// strings, booleans, integers, floats, durations, or implement both
+// `encoding.TextMarshaler` and `encoding.TextUnmarshaler`. An empty value is read as the zero value
+// unless the field is a string or a text unmarshaler.
+//
+// This will panic if the type isn't a struct or has an unsupported field.
+// The enumeration fails if the header is missing the column for a field,
+// has more than one column for a field, or a value fails to parse.
+func Unmarshal[T any](rows collections.TryEnumerator[[]string]) collections.TryEnumerator[T] {
+	fields := fieldsOf[T]()
+	return tryEnumerator.New(func() collections.TryIterator[T] {
+		it := rows.Iterate()
+		row := 0
+		var columns []int
+		var header []string
+		return tryEnumerator.NewIterator(func() (T, bool, error) {
+			if !it.Next() {
+				return utils.Zero[T](), false, it.Err()
+			}
+			if columns == nil {
+				header = it.Current()
+				var err error
+				if columns, err = fields.columns(header); err != nil {
+					return utils.Zero[T](), false, err
+				}
+				if !it.Next() {
+					return utils.Zero[T](), false, it.Err()
+				}
+			}
+			row++
+			values := it.Current()
+			if len(values) != len(header) {
+				return utils.Zero[T](), false, mismatch(row, 0, len(header), len(values))
+			}
+			var result T
+			value := reflect.ValueOf(&result).Elem()
+			for i, f := range fields {
+				column := columns[i]
+				if err := f.parse(value, values[column]); err != nil {
+					return utils.Zero[T](), false, terror.New(`failed to parse value`, err).
+						With(`row`, row).
+						With(`column`, header[column]).
+						With(`value`, values[column])
+				}
+			}
+			return result, true, nil
+		})
+	})
+}
+
+// Marshal writes a header row followed by a row for each struct.
+//
+// The header has a column for each field of the struct, named by the
+// field's tag or the field's name, in the order of the fields.
+// The fields may be strings, booleans, integers, floats, durations,
+// or implement both `encoding.TextMarshaler` and `encoding.TextUnmarshaler`.
+//
+// This will panic if the type isn't a struct or has an unsupported field.
+// The enumeration will panic if a text marshaler fails.
+func Marshal[T any](values collections.Enumerator[T]) collections.Enumerator[[]string] {
+	fields := fieldsOf[T]()
+	header := make([]string, len(fields))
+	for i, f := range fields {
+		header[i] = f.name
+	}
+	return enumerator.New(func() collections.Iterator[[]string] {
+		row := 0
+		body := iterator.Select(values.Iterate(), func(value T) []string {
+			row++
+			v := reflect.ValueOf(&value).Elem()
+			result := make([]string, len(fields))
+			for i, f := range fields {
+				text, err := f.format(v)
+				if err != nil {
+					panic(terror.New(`failed to format value`, err).
+						With(`row`, row).
+						With(`column`, f.name))
+				}
+				result[i] = text
+			}
+			return result
+		})
+		return iterator.Concat([]collections.Iterator[[]string]{
+			iterator.Iterate(header), body,
+		})
+	})
+}
@@ -0,0 +1,239 @@
+package records
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
+	"github.com/Snow-Gremlin/goToolbox/testers/check"
+)
+
+type level int
+
+func (l *level) UnmarshalText(text []byte) error {
+	*l = level(strings.Count(string(text), `*`))
+	return nil
+}
+
+func (l level) MarshalText() ([]byte, error) {
+	return []byte(strings.Repeat(`*`, int(l))), nil
+}
+
+// stars can only be read, so it isn't supported.
+type stars int
+
+func (s *stars) UnmarshalText(text []byte) error {
+	*s = stars(len(text))
+	return nil
+}
+
+type task struct {
+	Name     string        `record:"Task Name"`
+	Done     bool          `record:"Done"`
+	Hours    float64       `record:"Hours"`
+	Estimate time.Duration `record:"Estimate"`
+	Priority level         `record:"Priority"`
+	Skipped  int           `record:"-"`
+	Count    uint8
+	note     string
+}
+
+func Test_Records_ReadCSV(t *testing.T) {
+	rows, err := ReadCSV(strings.NewReader(
+		"name,says\n" +
+			"cat,meow\n" +
+			"\"dog, big\",\"\"\"woof\"\"\"\n")).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, [][]string{
+		{`name`, `says`},
+		{`cat`, `meow`},
+		{`dog, big`, `"woof"`},
+	}).Assert(rows)
+
+	_, err = ReadCSV(strings.NewReader("name,says\ncat,meow\ndog\n")).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 2\}: the number of values in the row doesn't match the header `+
+		`\{count: 1, expected: 2, line: 3, row: 2\}$`).Assert(err)
+
+	_, err = ReadCSV(strings.NewReader("name,says\ncat,\"meow\n")).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 1\}: failed to read row \{line: 2, position: 11, row: 1\}: parse error on line 2, column 11: .+$`).Assert(err)
+
+	check.MatchError(t, `^argument may not be nil \{name: r\}$`).Panic(func() {
+		ReadCSV(nil)
+	})
+}
+
+func Test_Records_ReadTSV(t *testing.T) {
+	rows, err := ReadTSV(strings.NewReader(
+		"name\tsays\n" +
+			"cat\tmeow, purr\n" +
+			"dog\t\"woof\tbark\"\n")).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, [][]string{
+		{`name`, `says`},
+		{`cat`, `meow, purr`},
+		{`dog`, "woof\tbark"},
+	}).Assert(rows)
+}
+
+func Test_Records_ReadMarkdown(t *testing.T) {
+	rows, err := ReadMarkdown(strings.NewReader(
+		"# Animals\n" +
+			"\n" +
+			"Some text | with a pipe\n" +
+			"\n" +
+			"| Name | Says      |\n" +
+			"|:-----|----------:|\n" +
+			"| cat  | meow      |\n" +
+			"  dog  | woof \\| bark\n" +
+			"| fish |           |\n" +
+			"\n" +
+			"| after | table |\n")).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, [][]string{
+		{`Name`, `Says`},
+		{`cat`, `meow`},
+		{`dog`, `woof | bark`},
+		{`fish`, ``},
+	}).Assert(rows)
+
+	rows, err = ReadMarkdown(strings.NewReader("# No Table\n\nJust text.\n")).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Empty(t).Assert(rows)
+
+	_, err = ReadMarkdown(strings.NewReader(
+		"Name | Says\n" +
+			"---- | ----\n" +
+			"cat  | meow | purr\n")).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 1\}: the number of values in the row doesn't match the header `+
+		`\{count: 3, expected: 2, line: 3, row: 1\}$`).Assert(err)
+
+	_, err = ReadMarkdown(strings.NewReader(
+		"Name | Says\n" +
+			"---- | ---- | ----\n")).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: the number of values in the row doesn't match the header `+
+		`\{count: 3, expected: 2, line: 2, row: 0\}$`).Assert(err)
+}
+
+func Test_Records_WriteCSV(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteCSV(buf, enumerator.Enumerate(
+		[]string{`name`, `says`},
+		[]string{`cat`, `meow`},
+		[]string{`dog, big`, `"woof"`}))
+	check.NoError(t).Assert(err)
+	check.Equal(t, "name,says\n"+
+		"cat,meow\n"+
+		"\"dog, big\",\"\"\"woof\"\"\"\n").Assert(buf.String())
+
+	buf.Reset()
+	err = WriteTSV(buf, enumerator.Enumerate(
+		[]string{`name`, `says`},
+		[]string{`dog`, "woof\tbark"}))
+	check.NoError(t).Assert(err)
+	check.Equal(t, "name\tsays\n"+
+		"dog\t\"woof\tbark\"\n").Assert(buf.String())
+
+	err = WriteCSV(buf, enumerator.Enumerate(
+		[]string{`name`, `says`},
+		[]string{`cat`}))
+	check.MatchError(t, `^the number of values in the row doesn't match the header \{count: 1, expected: 2, row: 1\}$`).Assert(err)
+}
+
+func Test_Records_WriteMarkdown(t *testing.T) {
+	buf := &bytes.Buffer{}
+	err := WriteMarkdown(buf, enumerator.Enumerate(
+		[]string{`Name`, `Says`, `Legs`, `X`},
+		[]string{`cat`, `meow`, `4`, `a`},
+		[]string{`ostrich`, "boom | hiss\nsnap", `2`, `bcdef`}),
+		AlignLeft, AlignCenter, AlignRight)
+	check.NoError(t).Assert(err)
+	check.Equal(t, "| Name    |         Says         | Legs | X     |\n"+
+		"|:--------|:--------------------:|-----:|-------|\n"+
+		"| cat     |         meow         |    4 | a     |\n"+
+		"| ostrich | boom \\| hiss<br>snap |    2 | bcdef |\n").Assert(buf.String())
+
+	rows, err := ReadMarkdown(strings.NewReader(buf.String())).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []string{`ostrich`, `boom | hiss<br>snap`, `2`, `bcdef`}).Assert(rows[2])
+
+	buf.Reset()
+	check.NoError(t).Assert(WriteMarkdown(buf, enumerator.Enumerate[[]string]()))
+	check.Equal(t, ``).Assert(buf.String())
+}
+
+func Test_Records_Unmarshal(t *testing.T) {
+	rows := ReadCSV(strings.NewReader(
+		"Extra,Task Name,Done,Hours,Estimate,Priority,Count\n" +
+			"x,write,true,1.5,2h,***,3\n" +
+			"y,review,,,,,\n"))
+	tasks, err := Unmarshal[task](rows).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []task{
+		{Name: `write`, Done: true, Hours: 1.5, Estimate: 2 * time.Hour, Priority: 3, Count: 3},
+		{Name: `review`},
+	}).Assert(tasks)
+
+	_, err = Unmarshal[task](ReadCSV(strings.NewReader(
+		"Task Name,Done,Hours,Estimate,Priority,Count\n" +
+			"write,maybe,1.5,2h,*,3\n"))).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: failed to parse value `+
+		`\{column: Done, row: 1, value: maybe\}: strconv.ParseBool: parsing "maybe": invalid syntax`).Assert(err)
+
+	_, err = Unmarshal[task](ReadCSV(strings.NewReader(
+		"Task Name,Done,Hours,Estimate,Priority,Count\n" +
+			"write,true,1.5,2h,*,300\n"))).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: failed to parse value `+
+		`\{column: Count, row: 1, value: 300\}: strconv.ParseUint: parsing "300": value out of range`).Assert(err)
+
+	_, err = Unmarshal[task](ReadCSV(strings.NewReader(
+		"Task Name,Done,Hours,Priority,Count\n"))).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: the header is missing the column for a field `+
+		`\{column: Estimate, field: Estimate, row: 0\}$`).Assert(err)
+
+	_, err = Unmarshal[task](ReadCSV(strings.NewReader(
+		"Task Name,Done,Hours,Estimate,Priority,Count,Done\n"))).ToSlice()
+	check.MatchError(t, `^failed to enumerate value \{index: 0\}: the header has more than one column for a field `+
+		`\{column: Done, field: Done, row: 0\}$`).Assert(err)
+
+	check.MatchError(t, `^the record type must be a struct \{type: int\}$`).Panic(func() {
+		Unmarshal[int](rows)
+	})
+	check.MatchError(t, `^the field type is not supported \{field: Values, field type: \[\]int, type: struct \{ Values \[\]int \}\}$`).Panic(func() {
+		Unmarshal[struct{ Values []int }](rows)
+	})
+	check.MatchError(t, `^the field type is not supported \{field: Rating, field type: records.stars, type: struct \{ Rating records.stars \}\}$`).Panic(func() {
+		Marshal(enumerator.Enumerate(struct{ Rating stars }{}))
+	})
+}
+
+func Test_Records_Marshal(t *testing.T) {
+	tasks := enumerator.Enumerate(
+		task{Name: `write`, Done: true, Hours: 1.5, Estimate: 90 * time.Minute, Priority: 2, Count: 3, note: `hidden`},
+		task{Name: `review, twice`, Skipped: 4})
+
+	buf := &bytes.Buffer{}
+	check.NoError(t).Assert(WriteCSV(buf, Marshal(tasks)))
+	check.Equal(t, "Task Name,Done,Hours,Estimate,Priority,Count\n"+
+		"write,true,1.5,1h30m0s,**,3\n"+
+		"\"review, twice\",false,0,0s,,0\n").Assert(buf.String())
+
+	back, err := Unmarshal[task](ReadCSV(strings.NewReader(buf.String()))).ToSlice()
+	check.NoError(t).Assert(err)
+	check.Equal(t, []task{
+		{Name: `write`, Done: true, Hours: 1.5, Estimate: 90 * time.Minute, Priority: 2, Count: 3},
+		{Name: `review, twice`},
+	}).Assert(back)
+
+	header, _ := Marshal(enumerator.Enumerate[task]()).First()
+	check.Equal(t, []string{`Task Name`, `Done`, `Hours`, `Estimate`, `Priority`, `Count`}).Assert(header)
+}
+
+func Test_Records_Enumerate(t *testing.T) {
+	var rows collections.Enumerator[[]string] = ReadCSV(strings.NewReader("a,b\n1,2\n3\n")).Enumerate()
+	check.MatchError(t, `^failed to enumerate value \{index: 2\}: the number of values in the row doesn't match the header`).Panic(func() {
+		rows.ToSlice()
+	})
+}
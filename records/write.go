@@ -0,0 +1,183 @@
+package records
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
+	"github.com/Snow-Gremlin/goToolbox/utils"
+)
+
+// Alignment is the alignment of the values in a column of a Markdown table.
+type Alignment int
+
+const (
+	// AlignDefault leaves the alignment of the column up to
+	// whatever renders the Markdown, typically left aligned.
+	AlignDefault Alignment = iota
+
+	// AlignLeft aligns the values of the column to the left.
+	AlignLeft
+
+	// AlignCenter centers the values of the column.
+	AlignCenter
+
+	// AlignRight aligns the values of the column to the right.
+	AlignRight
+)
+
+// WriteCSV writes the given rows as comma-separated values to the given writer.
+//
+// The first row is the header and every row must have the same number of
+// values as the header. Any value containing a comma, quote, or new line is quoted.
+func WriteCSV(w io.Writer, rows collections.Enumerator[[]string]) error {
+	return writeDelimited(w, rows, ',')
+}
+
+// WriteTSV writes the given rows as tab-separated values to the given writer.
+//
+// The first row is the header and every row must have the same number of
+// values as the header. Any value containing a tab, quote, or new line is quoted.
+func WriteTSV(w io.Writer, rows collections.Enumerator[[]string]) error {
+	return writeDelimited(w, rows, '\t')
+}
+
+// WriteMarkdown writes the given rows as a Markdown pipe table to the given writer.
+//
+// The first row is the header and every row must have the same number of
+// values as the header. The columns are padded so that the pipes line up.
+// Any pipe in a value is escaped and any new line is written as `<br>`.
+//
+// The optional alignments are the alignments for the columns in order.
+// Any column without an alignment uses the default alignment.
+// All the rows are read before any are written to determine the column widths.
+func WriteMarkdown(w io.Writer, rows collections.Enumerator[[]string], alignments ...Alignment) error {
+	if utils.IsNil(w) {
+		panic(terror.NilArg(`w`))
+	}
+
+	counter := newRowCounter()
+	table := [][]string{}
+	widths := []int{}
+	if err := rows.DoUntilError(func(values []string) error {
+		if err := counter.check(values, 0); err != nil {
+			return err
+		}
+		escaped := make([]string, len(values))
+		for i, value := range values {
+			escaped[i] = escapeMarkdown(value)
+		}
+		if len(table) <= 0 {
+			widths = make([]int, len(values))
+			for i := range widths {
+				widths[i] = 3
+			}
+		}
+		for i, value := range escaped {
+			widths[i] = max(widths[i], utf8.RuneCountInString(value))
+		}
+		table = append(table, escaped)
+		return nil
+	}); err != nil {
+		return err
+	}
+	if len(table) <= 0 {
+		return nil
+	}
+
+	align := func(column int) Alignment {
+		if column < len(alignments) {
+			return alignments[column]
+		}
+		return AlignDefault
+	}
+
+	buf := &strings.Builder{}
+	writeRow := func(values []string) {
+		buf.WriteString(`|`)
+		for i, value := range values {
+			buf.WriteString(` `)
+			buf.WriteString(pad(value, widths[i], align(i)))
+			buf.WriteString(` |`)
+		}
+		buf.WriteString("\n")
+	}
+
+	writeRow(table[0])
+	buf.WriteString(`|`)
+	for i, width := range widths {
+		buf.WriteString(delimiter(width, align(i)))
+		buf.WriteString(`|`)
+	}
+	buf.WriteString("\n")
+	for _, values := range table[1:] {
+		writeRow(values)
+	}
+
+	if _, err := io.WriteString(w, buf.String()); err != nil {
+		return terror.New(`failed to write markdown`, err)
+	}
+	return nil
+}
+
+// pad pads the given value with spaces to the given width with the given alignment.
+func pad(value string, width int, align Alignment) string {
+	padding := width - utf8.RuneCountInString(value)
+	switch align {
+	case AlignRight:
+		return strings.Repeat(` `, padding) + value
+	case AlignCenter:
+		left := padding / 2
+		return strings.Repeat(` `, left) + value + strings.Repeat(` `, padding-left)
+	default:
+		return value + strings.Repeat(` `, padding)
+	}
+}
+
+// delimiter gets the part of the delimiter row for a column with the given
+// width and alignment. The part includes the space on either side of the values.
+func delimiter(width int, align Alignment) string {
+	dashes := strings.Repeat(`-`, width)
+	switch align {
+	case AlignLeft:
+		return `:` + dashes + `-`
+	case AlignCenter:
+		return `:` + dashes + `:`
+	case AlignRight:
+		return `-` + dashes + `:`
+	default:
+		return `-` + dashes + `-`
+	}
+}
+
+// writeDelimited writes the given rows to the given writer
+// with the values separated by the given separator.
+func writeDelimited(w io.Writer, rows collections.Enumerator[[]string], comma rune) error {
+	if utils.IsNil(w) {
+		panic(terror.NilArg(`w`))
+	}
+	writer := csv.NewWriter(w)
+	writer.Comma = comma
+	counter := newRowCounter()
+	if err := rows.DoUntilError(func(values []string) error {
+		row := counter.row
+		if err := counter.check(values, 0); err != nil {
+			return err
+		}
+		if err := writer.Write(values); err != nil {
+			return terror.New(`failed to write row`, err).
+				With(`row`, row)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return terror.New(`failed to write rows`, err)
+	}
+	return nil
+}
@@ -15,7 +15,11 @@ func New(msg string, errs ...error) terrors.TError {
 		msg = `unknown error`
 	}
 
-	errs = slices.DeleteFunc(errs, liteUtils.IsZero)
+	// Only nil errors are removed since some errors, such as
+	// `context.DeadlineExceeded`, are zero values which aren't nil.
+	errs = slices.DeleteFunc(errs, func(err error) bool {
+		return liteUtils.IsNil(err)
+	})
 
 	return &tErrorImp{
 		msg:     msg,
@@ -1,6 +1,7 @@
 package terror
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"regexp"
@@ -64,6 +65,10 @@ func Test_TError(t *testing.T) {
 
 	_ = e4.WithError(e6)
 	checkMatch(t, `^Hello: \[World - unknown error: unknown error, Blue\]$`, e6)
+
+	e9 := New(`Timeout`, nil, context.DeadlineExceeded)
+	checkEqual(t, []error{context.DeadlineExceeded}, e9.Unwrap())
+	checkMatch(t, `^Timeout: context deadline exceeded$`, e9)
 }
 
 func Test_TError_With(t *testing.T) {
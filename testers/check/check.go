@@ -11,6 +11,7 @@ import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
 	"github.com/Snow-Gremlin/goToolbox/collections/enumerator"
 	"github.com/Snow-Gremlin/goToolbox/collections/predicate"
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate/explain"
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyVariantList"
 	"github.com/Snow-Gremlin/goToolbox/comp"
 	"github.com/Snow-Gremlin/goToolbox/internal/optional"
@@ -378,6 +379,7 @@ func Negative[T any](t testers.Tester, comparer ...comp.Comparer[T]) (c testers.
 }
 
 // Is creates a check that the actual value causes the given predicate to return true.
+// To have the reason for a failure included, use `Satisfies` with an explainable predicate.
 //
 // Example: check.Is(t, func(x thing) bool { return thing.Valid() }).Assert(actual)
 func Is[T any](t testers.Tester, p collections.Predicate[T]) (c testers.Check[T]) {
@@ -387,6 +389,7 @@ func Is[T any](t testers.Tester, p collections.Predicate[T]) (c testers.Check[T]
 }
 
 // IsNot creates a check that the actual value causes the given predicate to return false.
+// To have the reason for a failure included, use `Satisfies` with `explain.Not`.
 //
 // Example: check.IsNot(t, func(x thing) bool { return thing.Valid() }).Assert(actual)
 func IsNot[T any](t testers.Tester, p collections.Predicate[T]) (c testers.Check[T]) {
@@ -395,6 +398,17 @@ func IsNot[T any](t testers.Tester, p collections.Predicate[T]) (c testers.Check
 	return newPred(t, predicate.Not(p), `not be accepted by the given predicate`)
 }
 
+// Satisfies creates a check that the actual value passes the given explainable predicate.
+// On failure, the explanation is included as a pass/fail tree showing
+// why each of the sub-predicates passed or failed.
+//
+// Example: check.Satisfies(t, explain.And(explain.Pos[int](), explain.LessThan(10))).Assert(actual)
+func Satisfies[T any](t testers.Tester, p explain.Predicate[T]) (c testers.Check[T]) {
+	defer handlePanic(t, &c)
+	getHelper(t)()
+	return newExplained(t, p, `satisfy the given predicate`)
+}
+
 // StartsWith creates a check that the given expected
 // string or array is the prefix for the actual object.
 //
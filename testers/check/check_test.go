@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate/explain"
 	"github.com/Snow-Gremlin/goToolbox/collections/tuple2"
 	"github.com/Snow-Gremlin/goToolbox/differs/data"
 	"github.com/Snow-Gremlin/goToolbox/differs/diff"
@@ -873,6 +874,19 @@ func Test_Check_IsNot(t *testing.T) {
 		`\tName:         Is not leap year\?`)
 }
 
+func Test_Check_Satisfies(t *testing.T) {
+	pt := newTester(t)
+	p := explain.And(explain.GreaterThan(3), explain.LessThan(10))
+	Satisfies(pt, p).Assert(5)
+	Satisfies(pt, p).Assert(12)
+	pt.Check(`Should satisfy the given predicate:`,
+		`\tActual Type:  int`,
+		`\tActual Value: 12`,
+		`\tExplanation:  ✗ And: 1 of 2 passed`,
+		`\t                ✓ GreaterThan\(3\): 12 is > 3`,
+		`\t                ✗ LessThan\(10\): 12 is not < 10`)
+}
+
 func Test_Check_StartWith(t *testing.T) {
 	pt := newTester(t)
 	StartsWith(pt, 12).Assert([]int{1, 2, 3, 4, 5})
@@ -2,7 +2,9 @@ package check
 
 import (
 	"github.com/Snow-Gremlin/goToolbox/collections"
+	"github.com/Snow-Gremlin/goToolbox/collections/predicate/explain"
 	"github.com/Snow-Gremlin/goToolbox/collections/readonlyVariantList"
+	"github.com/Snow-Gremlin/goToolbox/terrors/terror"
 	"github.com/Snow-Gremlin/goToolbox/testers"
 	"github.com/Snow-Gremlin/goToolbox/utils"
 )
@@ -29,6 +31,18 @@ func newPred[T any](t testers.Tester, p collections.Predicate[T], action string)
 	})
 }
 
+func newExplained[T any](t testers.Tester, p explain.Predicate[T], action string) *checkImp[T] {
+	if utils.IsNil(p) {
+		panic(terror.NilArg(`p`))
+	}
+	return newCheck(t, func(b *testee, actual T) {
+		if e := p.Explain(actual); !e.Passed {
+			b.With(`Explanation`, e.String()).
+				Should(action)
+		}
+	})
+}
+
 func newLen[T any](t testers.Tester, p collections.Predicate[int], action string) *checkImp[T] {
 	return newCheck(t, func(b *testee, actual T) {
 		if length, ok := utils.Length(actual); !ok {